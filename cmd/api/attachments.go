@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/blob"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxAttachmentBytes caps upload size unless ATTACHMENT_MAX_BYTES is set.
+const defaultMaxAttachmentBytes = 25 << 20
+
+// downloadChunkSize is the size of each chunk frame sent by DownloadAttachment.
+const downloadChunkSize = 64 << 10
+
+// attachmentAuthScan caps how many messages carrying an attachment are checked
+// when authorizing a download.
+const attachmentAuthScan = 100
+
+// errAttachmentTooLarge is returned by uploadReader once the size cap is exceeded.
+var errAttachmentTooLarge = errors.New("attachment too large")
+
+// UploadAttachment reads a metadata frame followed by chunk frames, stores the
+// contents in the blob store and records the attachment's metadata
+func (s *Server) UploadAttachment(stream v1.ChatService_UploadAttachmentServer) error {
+	claims, ok := getClaimsFromContext(stream.Context())
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "missing attachment metadata")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "receive error: %v", err)
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Errorf(codes.InvalidArgument, "first frame must carry attachment metadata")
+	}
+
+	// The blob is written under the attachment's ID before the metadata exists,
+	// so a failed upload never leaves a dangling attachment record
+	att := &data.Attachment{
+		ID:          bson.NewObjectID(),
+		OwnerEmail:  claims.Email,
+		FileName:    meta.GetFileName(),
+		ContentType: meta.GetContentType(),
+		CreatedAt:   time.Now(),
+	}
+	size, err := s.blobs.Put(stream.Context(), att.ID.Hex(), &uploadReader{stream: stream, max: s.maxAttachmentBytes})
+	if err != nil {
+		if errors.Is(err, errAttachmentTooLarge) {
+			return status.Errorf(codes.ResourceExhausted, "attachment exceeds %d bytes", s.maxAttachmentBytes)
+		}
+		if st, ok := status.FromError(err); ok {
+			return st.Err()
+		}
+		return status.Errorf(codes.Internal, "failed to store attachment: %v", err)
+	}
+	att.Size = size
+
+	if err := s.atts.CreateAttachment(stream.Context(), att); err != nil {
+		if derr := s.blobs.Delete(context.Background(), att.ID.Hex()); derr != nil {
+			log.Printf("failed to clean up blob %s: %v", att.ID.Hex(), derr)
+		}
+		return status.Errorf(codes.Internal, "failed to save attachment: %v", err)
+	}
+
+	return stream.SendAndClose(&v1.UploadAttachmentResponse{Attachment: attachmentToProto(att.Ref())})
+}
+
+// DownloadAttachment streams an attachment to its uploader or to a participant
+// of a conversation it was sent to
+func (s *Server) DownloadAttachment(req *v1.DownloadAttachmentRequest, stream v1.ChatService_DownloadAttachmentServer) error {
+	claims, ok := getClaimsFromContext(stream.Context())
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	att, err := s.loadAttachment(stream.Context(), req.GetAttachmentId())
+	if err != nil {
+		return err
+	}
+	allowed, err := s.canDownload(stream.Context(), att, claims.Email)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to authorize download: %v", err)
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "not allowed to download this attachment")
+	}
+
+	rc, err := s.blobs.Open(stream.Context(), att.ID.Hex())
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "attachment contents missing")
+		}
+		return status.Errorf(codes.Internal, "failed to open attachment: %v", err)
+	}
+	defer rc.Close()
+
+	if err := stream.Send(&v1.DownloadAttachmentResponse{
+		Frame: &v1.DownloadAttachmentResponse_Attachment{Attachment: attachmentToProto(att.Ref())},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send attachment: %v", err)
+	}
+
+	// Send marshals the frame before returning, so the buffer can be reused
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if serr := stream.Send(&v1.DownloadAttachmentResponse{
+				Frame: &v1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); serr != nil {
+				return status.Errorf(codes.Internal, "failed to send attachment: %v", serr)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+}
+
+// loadAttachment parses a hex attachment ID and fetches its metadata, mapping
+// failures to gRPC statuses.
+func (s *Server) loadAttachment(ctx context.Context, attachmentID string) (*data.Attachment, error) {
+	id, err := bson.ObjectIDFromHex(attachmentID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment_id")
+	}
+
+	att, err := s.atts.GetAttachment(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrAttachmentNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attachment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load attachment: %v", err)
+	}
+	return att, nil
+}
+
+// canDownload reports whether email uploaded att or takes part in a
+// conversation att was sent to. Avatars can be downloaded by everyone.
+// Messages deleted for everyone no longer grant access.
+func (s *Server) canDownload(ctx context.Context, att *data.Attachment, email string) (bool, error) {
+	if att.OwnerEmail == email {
+		return true, nil
+	}
+	avatar, err := s.users.AvatarInUse(ctx, att.ID)
+	if err != nil {
+		return false, err
+	}
+	if avatar {
+		return true, nil
+	}
+
+	msgs, err := s.msgs.GetMessagesWithAttachment(ctx, att.ID, attachmentAuthScan)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range msgs {
+		if !m.DeletedAt.IsZero() {
+			continue
+		}
+		emails, err := s.participants(ctx, m)
+		if err != nil {
+			return false, err
+		}
+		if slices.Contains(emails, email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// messageAttachments resolves the attachments referenced by an outgoing
+// message. Only the uploader may send an attachment.
+func (s *Server) messageAttachments(ctx context.Context, fromEmail string, ids []string) ([]data.AttachmentRef, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	refs := make([]data.AttachmentRef, 0, len(ids))
+	for _, id := range ids {
+		att, err := s.loadAttachment(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if att.OwnerEmail != fromEmail {
+			return nil, status.Errorf(codes.PermissionDenied, "attachment %s was not uploaded by the sender", id)
+		}
+		refs = append(refs, att.Ref())
+	}
+	return refs, nil
+}
+
+// attachmentsToProto converts stored attachment refs into their API representation.
+func attachmentsToProto(refs []data.AttachmentRef) []*v1.Attachment {
+	out := make([]*v1.Attachment, 0, len(refs))
+	for _, r := range refs {
+		out = append(out, attachmentToProto(r))
+	}
+	return out
+}
+
+// attachmentToProto converts one stored attachment ref into its API representation.
+func attachmentToProto(r data.AttachmentRef) *v1.Attachment {
+	return &v1.Attachment{
+		AttachmentId: r.ID.Hex(),
+		FileName:     r.FileName,
+		ContentType:  r.ContentType,
+		Size:         r.Size,
+	}
+}
+
+// uploadReader adapts the chunk frames of an UploadAttachment stream to an
+// io.Reader, failing once more than max bytes arrive.
+type uploadReader struct {
+	stream v1.ChatService_UploadAttachmentServer
+	max    int64
+	read   int64
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	// Empty chunks are skipped so a zero-length read never signals EOF early
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err // io.EOF ends the upload
+		}
+		chunk, ok := req.GetFrame().(*v1.UploadAttachmentRequest_Chunk)
+		if !ok {
+			return 0, status.Errorf(codes.InvalidArgument, "only chunk frames may follow metadata")
+		}
+		r.read += int64(len(chunk.Chunk))
+		if r.read > r.max {
+			return 0, fmt.Errorf("%w: more than %d bytes", errAttachmentTooLarge, r.max)
+		}
+		r.buf = chunk.Chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fakeUploadStream replays queued frames, then io.EOF.
+type fakeUploadStream struct {
+	v1.ChatService_UploadAttachmentServer
+	frames []*v1.UploadAttachmentRequest
+}
+
+func (f *fakeUploadStream) Recv() (*v1.UploadAttachmentRequest, error) {
+	if len(f.frames) == 0 {
+		return nil, io.EOF
+	}
+	req := f.frames[0]
+	f.frames = f.frames[1:]
+	return req, nil
+}
+
+func chunkFrame(b string) *v1.UploadAttachmentRequest {
+	return &v1.UploadAttachmentRequest{Frame: &v1.UploadAttachmentRequest_Chunk{Chunk: []byte(b)}}
+}
+
+func TestUploadReader_ConcatenatesChunks(t *testing.T) {
+	stream := &fakeUploadStream{frames: []*v1.UploadAttachmentRequest{
+		chunkFrame("hello "), chunkFrame(""), chunkFrame("world"),
+	}}
+
+	got, err := io.ReadAll(&uploadReader{stream: stream, max: 100})
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if string(got) != "hello world" {
+		t.Fatalf("unexpected contents %q", got)
+	}
+}
+
+func TestUploadReader_EnforcesMax(t *testing.T) {
+	stream := &fakeUploadStream{frames: []*v1.UploadAttachmentRequest{
+		chunkFrame("12345"), chunkFrame("6789"),
+	}}
+
+	_, err := io.ReadAll(&uploadReader{stream: stream, max: 8})
+	if !errors.Is(err, errAttachmentTooLarge) {
+		t.Fatalf("expected errAttachmentTooLarge, got %v", err)
+	}
+}
+
+func TestUploadReader_RejectsSecondMetadata(t *testing.T) {
+	stream := &fakeUploadStream{frames: []*v1.UploadAttachmentRequest{
+		{Frame: &v1.UploadAttachmentRequest_Metadata{Metadata: &v1.AttachmentMetadata{FileName: "x"}}},
+	}}
+
+	if _, err := io.ReadAll(&uploadReader{stream: stream, max: 8}); err == nil {
+		t.Fatalf("expected error for metadata after the first frame")
+	}
+}
+
+// fakeAttachmentMsgs serves GetMessagesWithAttachment from a fixed list.
+type fakeAttachmentMsgs struct {
+	MessagesStore
+	msgs []*data.Message
+}
+
+func (f *fakeAttachmentMsgs) GetMessagesWithAttachment(context.Context, bson.ObjectID, int64) ([]*data.Message, error) {
+	return f.msgs, nil
+}
+
+// fakeAvatars reports that no attachment is in use as an avatar.
+type fakeAvatars struct {
+	UsersStore
+}
+
+func (fakeAvatars) AvatarInUse(context.Context, bson.ObjectID) (bool, error) {
+	return false, nil
+}
+
+func TestCanDownload_SkipsDeletedMessages(t *testing.T) {
+	ctx := context.Background()
+	att := &data.Attachment{ID: bson.NewObjectID(), OwnerEmail: "alice@example.com"}
+	msg := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", ToEmail: "bob@example.com"}
+	s := &Server{users: fakeAvatars{}, msgs: &fakeAttachmentMsgs{msgs: []*data.Message{msg}}}
+
+	if ok, err := s.canDownload(ctx, att, "bob@example.com"); err != nil || !ok {
+		t.Fatalf("expected the recipient to download, got %v, %v", ok, err)
+	}
+	if ok, err := s.canDownload(ctx, att, "carol@example.com"); err != nil || ok {
+		t.Fatalf("expected an outsider to be refused, got %v, %v", ok, err)
+	}
+
+	// once deleted for everyone the message no longer grants access
+	msg.DeletedAt = time.Now()
+	if ok, err := s.canDownload(ctx, att, "bob@example.com"); err != nil || ok {
+		t.Fatalf("expected download via a deleted message to be refused, got %v, %v", ok, err)
+	}
+	if ok, err := s.canDownload(ctx, att, "alice@example.com"); err != nil || !ok {
+		t.Fatalf("expected the uploader to keep access, got %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BlockUser blocks a user: neither side can message the other in 1-on-1 chats
+// or add the other to a group, messages and events from one no longer reach
+// the other in shared groups, and the blocked user disappears from the
+// caller's chat list and presence.
+// Blocking someone twice is a no-op.
+func (s *Server) BlockUser(ctx context.Context, req *v1.BlockUserRequest) (*v1.BlockUserResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	email := normalize.Email(req.GetEmail())
+	if email == claims.Email {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot block yourself")
+	}
+
+	exists, err := s.users.UserExists(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify user: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	block, err := s.blocks.Block(ctx, claims.Email, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block user: %v", err)
+	}
+	// Pending group invites between the two can no longer be accepted
+	for _, pair := range [][2]string{{claims.Email, email}, {email, claims.Email}} {
+		if err := s.groups.DropInvites(ctx, pair[0], pair[1]); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to drop group invites: %v", err)
+		}
+	}
+	return &v1.BlockUserResponse{Blocked: blockedToProto(block)}, nil
+}
+
+// UnblockUser lifts the caller's block on a user. Unblocking a user who isn't
+// blocked is a no-op.
+func (s *Server) UnblockUser(ctx context.Context, req *v1.UnblockUserRequest) (*v1.UnblockUserResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	email := normalize.Email(req.GetEmail())
+
+	if err := s.blocks.Unblock(ctx, claims.Email, email); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unblock user: %v", err)
+	}
+	return &v1.UnblockUserResponse{Email: email}, nil
+}
+
+// ListBlocked returns the users the caller has blocked, most recent first.
+func (s *Server) ListBlocked(ctx context.Context, req *v1.ListBlockedRequest) (*v1.ListBlockedResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	blocks, err := s.blocks.ListBlocked(ctx, claims.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list blocked users: %v", err)
+	}
+	resp := &v1.ListBlockedResponse{Blocked: make([]*v1.BlockedUser, 0, len(blocks))}
+	for _, b := range blocks {
+		resp.Blocked = append(resp.Blocked, blockedToProto(b))
+	}
+	return resp, nil
+}
+
+// blockedEmails returns the emails of users with a block with email in either
+// direction.
+func (s *Server) blockedEmails(ctx context.Context, email string) ([]string, error) {
+	return s.blocks.BlockedWith(ctx, email)
+}
+
+// blocked reports whether either of a and b has blocked the other.
+func (s *Server) blocked(ctx context.Context, a, b string) (bool, error) {
+	block, err := s.blocks.BlockBetween(ctx, a, b)
+	if err != nil {
+		return false, err
+	}
+	return block != nil, nil
+}
+
+// requireUnblocked fails with PermissionDenied when either of caller and other
+// has blocked the other.
+func (s *Server) requireUnblocked(ctx context.Context, caller, other string) error {
+	blocked, err := s.blocked(ctx, caller, other)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+	if blocked {
+		return status.Errorf(codes.PermissionDenied, "messages between you and %s are blocked", other)
+	}
+	return nil
+}
+
+// splitBlocked splits emails into those without a block with email in either
+// direction and those with one, checking them all in one query. email itself
+// always counts as unblocked.
+func (s *Server) splitBlocked(ctx context.Context, email string, emails []string) (unblocked, blocked []string, err error) {
+	among, err := s.blocks.BlockedAmong(ctx, email, emails)
+	if err != nil {
+		return nil, nil, err
+	}
+	unblocked = make([]string, 0, len(emails))
+	for _, e := range emails {
+		if e != email && slices.Contains(among, e) {
+			blocked = append(blocked, e)
+			continue
+		}
+		unblocked = append(unblocked, e)
+	}
+	return unblocked, blocked, nil
+}
+
+// sendError builds the ChatStream event refusing an outgoing message.
+func sendError(msg *v1.OutgoingMessage, reason v1.SendErrorReason, text string) *v1.ChatStreamResponse {
+	return &v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_SendError{
+			SendError: &v1.SendError{
+				ToEmail:     msg.GetToEmail(),
+				GroupId:     msg.GetGroupId(),
+				ClientMsgId: msg.GetClientMsgId(),
+				Reason:      reason,
+				Message:     text,
+			},
+		},
+	}
+}
+
+// blockedToProto converts a stored block into its API representation.
+func blockedToProto(b *data.Block) *v1.BlockedUser {
+	return &v1.BlockedUser{
+		Email:     b.BlockedEmail,
+		BlockedAt: timestamppb.New(b.CreatedAt),
+	}
+}
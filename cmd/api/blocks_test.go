@@ -0,0 +1,396 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBlocks is an in-memory BlocksStore keyed by "blocker|blocked".
+type fakeBlocks struct {
+	pairs map[string]bool
+}
+
+func (f *fakeBlocks) Block(_ context.Context, blocker, blocked string) (*data.Block, error) {
+	if f.pairs == nil {
+		f.pairs = make(map[string]bool)
+	}
+	f.pairs[blocker+"|"+blocked] = true
+	return &data.Block{BlockerEmail: blocker, BlockedEmail: blocked}, nil
+}
+
+func (f *fakeBlocks) Unblock(_ context.Context, blocker, blocked string) error {
+	delete(f.pairs, blocker+"|"+blocked)
+	return nil
+}
+
+func (f *fakeBlocks) ListBlocked(context.Context, string) ([]*data.Block, error) {
+	return nil, nil
+}
+
+func (f *fakeBlocks) BlockBetween(_ context.Context, a, b string) (*data.Block, error) {
+	if f.pairs[a+"|"+b] {
+		return &data.Block{BlockerEmail: a, BlockedEmail: b}, nil
+	}
+	if f.pairs[b+"|"+a] {
+		return &data.Block{BlockerEmail: b, BlockedEmail: a}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeBlocks) BlockedWith(_ context.Context, email string) ([]string, error) {
+	var emails []string
+	for pair := range f.pairs {
+		blocker, blocked, _ := strings.Cut(pair, "|")
+		switch email {
+		case blocker:
+			emails = append(emails, blocked)
+		case blocked:
+			emails = append(emails, blocker)
+		}
+	}
+	return emails, nil
+}
+
+func (f *fakeBlocks) BlockedAmong(ctx context.Context, email string, emails []string) ([]string, error) {
+	var among []string
+	for _, e := range emails {
+		if b, _ := f.BlockBetween(ctx, email, e); b != nil {
+			among = append(among, e)
+		}
+	}
+	return among, nil
+}
+
+func TestRelayTyping_Blocked(t *testing.T) {
+	hub := NewConnectionHub()
+	bob := &fakeSender{}
+	hub.Register("bob@example.com", bob)
+
+	blocks := &fakeBlocks{}
+	_, _ = blocks.Block(context.Background(), "bob@example.com", "alice@example.com")
+	s := &Server{hub: hub, blocks: blocks}
+
+	s.relayTyping(context.Background(), "alice@example.com", &v1.TypingEvent{ToEmail: "bob@example.com"}, true)
+	if bob.last != nil {
+		t.Fatalf("expected no typing event from a blocked user, got %v", bob.last)
+	}
+
+	// blocks apply both ways
+	if blocked, _ := s.blocked(context.Background(), "alice@example.com", "bob@example.com"); !blocked {
+		t.Fatal("expected the block to be seen from either side")
+	}
+}
+
+func TestSendError(t *testing.T) {
+	msg := &v1.OutgoingMessage{ToEmail: "bob@example.com", ClientMsgId: "c1"}
+	got := sendError(msg, v1.SendErrorReason_SEND_ERROR_REASON_BLOCKED, "blocked").GetSendError()
+	if got.GetToEmail() != "bob@example.com" || got.GetClientMsgId() != "c1" || got.GetReason() != v1.SendErrorReason_SEND_ERROR_REASON_BLOCKED {
+		t.Fatalf("unexpected send error: %+v", got)
+	}
+}
+
+// existingUsers is a UsersStore in which every user exists.
+type existingUsers struct {
+	UsersStore
+}
+
+func (existingUsers) UserExists(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+// fakeGroups serves a single group from memory.
+type fakeGroups struct {
+	GroupsStore
+	group *data.Group
+}
+
+func (f *fakeGroups) GetGroup(context.Context, bson.ObjectID) (*data.Group, error) {
+	return f.group, nil
+}
+
+func (f *fakeGroups) ListGroupsForUser(_ context.Context, email string) ([]*data.Group, error) {
+	if f.group != nil && f.group.HasMember(email) {
+		return []*data.Group{f.group}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeGroups) CreateGroup(_ context.Context, name, owner string, members []string) (*data.Group, error) {
+	f.group = &data.Group{ID: bson.NewObjectID(), Name: name, OwnerEmail: owner, Members: append([]string{owner}, members...)}
+	return f.group, nil
+}
+
+func (f *fakeGroups) AddMember(_ context.Context, _ bson.ObjectID, email string) (*data.Group, error) {
+	f.group.Members = append(f.group.Members, email)
+	return f.group, nil
+}
+
+func (f *fakeGroups) Invite(_ context.Context, _ bson.ObjectID, email, invitedBy string) (*data.Group, bool, error) {
+	if f.group.HasMember(email) || f.group.HasInvite(email) {
+		return f.group, false, nil
+	}
+	f.group.Invites = append(f.group.Invites, data.GroupInvite{Email: email, InvitedBy: invitedBy})
+	return f.group, true, nil
+}
+
+func (f *fakeGroups) AcceptInvites(_ context.Context, email, invitedBy string) (int64, error) {
+	if f.group == nil || !slices.Contains(f.group.Invites, data.GroupInvite{Email: email, InvitedBy: invitedBy}) {
+		return 0, nil
+	}
+	f.group.Members = append(f.group.Members, email)
+	return 1, f.DropInvites(context.Background(), email, invitedBy)
+}
+
+func (f *fakeGroups) DropInvites(_ context.Context, email, invitedBy string) error {
+	if f.group != nil {
+		f.group.Invites = slices.DeleteFunc(f.group.Invites, func(i data.GroupInvite) bool {
+			return i.Email == email && i.InvitedBy == invitedBy
+		})
+	}
+	return nil
+}
+
+// fakeGroupMsgs stores inserted messages and serves reactions and pins on a
+// single message.
+type fakeGroupMsgs struct {
+	MessagesStore
+	msg      *data.Message
+	inserted []*data.Message
+}
+
+func (f *fakeGroupMsgs) InsertMessage(_ context.Context, m *data.Message) (*data.Message, error) {
+	m.ID = bson.NewObjectID()
+	f.inserted = append(f.inserted, m)
+	return m, nil
+}
+
+func (f *fakeGroupMsgs) GetPending(_ context.Context, from, to string, after *data.HistoryCursor, limit int64) ([]*data.Message, error) {
+	var pending []*data.Message
+	past := after == nil
+	for _, m := range f.inserted {
+		if !past {
+			past = m.ID == after.ID
+			continue
+		}
+		if m.Pending && m.FromEmail == from && m.ToEmail == to && int64(len(pending)) < limit {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+func (f *fakeGroupMsgs) ClearPending(_ context.Context, from, to string) error {
+	for _, m := range f.inserted {
+		if m.FromEmail == from && m.ToEmail == to {
+			m.Pending = false
+		}
+	}
+	return nil
+}
+
+func (f *fakeGroupMsgs) GetMessage(context.Context, bson.ObjectID) (*data.Message, error) {
+	return f.msg, nil
+}
+
+func (f *fakeGroupMsgs) React(_ context.Context, _ bson.ObjectID, email, emoji string, _ int) (*data.Message, error) {
+	f.msg.Reactions = append(f.msg.Reactions, data.Reaction{Email: email, Emoji: emoji})
+	return f.msg, nil
+}
+
+func (f *fakeGroupMsgs) PinMessage(_ context.Context, _ bson.ObjectID, email string, pinnedAt time.Time, _ int) (*data.Message, error) {
+	f.msg.PinnedAt, f.msg.PinnedBy = pinnedAt, email
+	return f.msg, nil
+}
+
+// recordingConvs records the participants of every RecordMessage call.
+type recordingConvs struct {
+	ConversationsStore
+	participants [][]string
+}
+
+func (f *recordingConvs) RecordMessage(_ context.Context, _ *data.Message, participants []string) error {
+	f.participants = append(f.participants, participants)
+	return nil
+}
+
+// fakeChatStream collects what a ChatStream handler sends to the caller.
+type fakeChatStream struct {
+	v1.ChatService_ChatStreamServer
+	ctx  context.Context
+	sent []*v1.ChatStreamResponse
+}
+
+func (f *fakeChatStream) Context() context.Context { return f.ctx }
+
+func (f *fakeChatStream) Send(r *v1.ChatStreamResponse) error {
+	f.sent = append(f.sent, r)
+	return nil
+}
+
+// blockedGroupServer returns a server with a group of alice, bob and carol in
+// which carol has blocked alice, plus the hub streams of bob and carol.
+func blockedGroupServer(t *testing.T) (*Server, *fakeSender, *fakeSender) {
+	t.Helper()
+	hub := NewConnectionHub()
+	bob, carol := &fakeSender{}, &fakeSender{}
+	hub.Register("bob@example.com", bob)
+	hub.Register("carol@example.com", carol)
+
+	blocks := &fakeBlocks{}
+	_, _ = blocks.Block(context.Background(), "carol@example.com", "alice@example.com")
+	group := &data.Group{ID: bson.NewObjectID(), OwnerEmail: "bob@example.com", Members: []string{"bob@example.com", "alice@example.com", "carol@example.com"}}
+	// everyone takes messages from everyone, so nobody gets a group invite
+	contacts := &fakeContacts{}
+	for _, a := range group.Members {
+		for _, b := range group.Members {
+			_, _ = contacts.Accept(context.Background(), a, b)
+		}
+	}
+	return &Server{
+		hub:      hub,
+		blocks:   blocks,
+		contacts: contacts,
+		users:    existingUsers{},
+		groups:   &fakeGroups{group: group},
+		msgs:     &fakeGroupMsgs{},
+		convs:    &recordingConvs{},
+		maxPins:  defaultMaxPins,
+	}, bob, carol
+}
+
+func claimsContext(email string) context.Context {
+	return context.WithValue(context.Background(), authContextKey{}, &auth.Claims{Email: email})
+}
+
+func TestGroupMembership_Blocked(t *testing.T) {
+	s, _, _ := blockedGroupServer(t)
+
+	// alice can't pull carol, who blocked her, into a group
+	_, err := s.CreateGroup(claimsContext("alice@example.com"), &v1.CreateGroupRequest{Name: "x", MemberEmails: []string{"bob@example.com", "carol@example.com"}})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("CreateGroup with a blocker: got %v, want PermissionDenied", err)
+	}
+
+	owned := &data.Group{ID: bson.NewObjectID(), OwnerEmail: "alice@example.com", Members: []string{"alice@example.com"}}
+	s.groups = &fakeGroups{group: owned}
+	_, err = s.AddMember(claimsContext("alice@example.com"), &v1.AddMemberRequest{GroupId: owned.ID.Hex(), Email: "carol@example.com"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("AddMember of a blocker: got %v, want PermissionDenied", err)
+	}
+	if len(owned.Members) != 1 {
+		t.Fatalf("carol should not have been added: %v", owned.Members)
+	}
+	if _, err := s.AddMember(claimsContext("alice@example.com"), &v1.AddMemberRequest{GroupId: owned.ID.Hex(), Email: "bob@example.com"}); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+}
+
+func TestSendGroupMessage_HidesFromBlocked(t *testing.T) {
+	s, bob, carol := blockedGroupServer(t)
+	group := s.groups.(*fakeGroups).group
+	stream := &fakeChatStream{ctx: context.Background()}
+
+	if err := s.sendGroupMessage(stream, "alice@example.com", &v1.OutgoingMessage{GroupId: group.ID.Hex(), Content: "hi"}); err != nil {
+		t.Fatalf("sendGroupMessage failed: %v", err)
+	}
+	if bob.last == nil || carol.last != nil {
+		t.Fatalf("expected only bob to receive the message, bob=%v carol=%v", bob.last, carol.last)
+	}
+
+	// carol never sees it later either: it is hidden for her and left out of her chat list
+	saved := s.msgs.(*fakeGroupMsgs).inserted[0]
+	if len(saved.HiddenFor) != 1 || saved.HiddenFor[0] != "carol@example.com" {
+		t.Fatalf("expected the message hidden for carol, got %v", saved.HiddenFor)
+	}
+	recorded := s.convs.(*recordingConvs).participants[0]
+	if len(recorded) != 2 || recorded[0] != "bob@example.com" || recorded[1] != "alice@example.com" {
+		t.Fatalf("unexpected conversation participants: %v", recorded)
+	}
+}
+
+func TestReactionAndPin_SkipBlocked(t *testing.T) {
+	s, bob, carol := blockedGroupServer(t)
+	group := s.groups.(*fakeGroups).group
+	msg := &data.Message{ID: bson.NewObjectID(), FromEmail: "bob@example.com", GroupID: group.ID, Content: "plan"}
+	s.msgs.(*fakeGroupMsgs).msg = msg
+
+	if _, err := s.React(claimsContext("alice@example.com"), &v1.ReactRequest{MsgId: msg.ID.Hex(), Emoji: "👍"}); err != nil {
+		t.Fatalf("React failed: %v", err)
+	}
+	if bob.last.GetReaction() == nil || carol.last != nil {
+		t.Fatalf("expected only bob to hear about the reaction, bob=%v carol=%v", bob.last, carol.last)
+	}
+
+	if _, err := s.PinMessage(claimsContext("alice@example.com"), &v1.PinMessageRequest{MsgId: msg.ID.Hex()}); err != nil {
+		t.Fatalf("PinMessage failed: %v", err)
+	}
+	if bob.last.GetPin() == nil || carol.last != nil {
+		t.Fatalf("expected only bob to hear about the pin, bob=%v carol=%v", bob.last, carol.last)
+	}
+
+	// in a 1-on-1 chat the blocked user can't react or pin at all
+	direct := &data.Message{ID: bson.NewObjectID(), FromEmail: "carol@example.com", ToEmail: "alice@example.com", Content: "old"}
+	s.msgs.(*fakeGroupMsgs).msg = direct
+	if _, err := s.React(claimsContext("alice@example.com"), &v1.ReactRequest{MsgId: direct.ID.Hex(), Emoji: "👍"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("React across a block: got %v, want PermissionDenied", err)
+	}
+	if _, err := s.PinMessage(claimsContext("alice@example.com"), &v1.PinMessageRequest{MsgId: direct.ID.Hex()}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("PinMessage across a block: got %v, want PermissionDenied", err)
+	}
+	if carol.last != nil {
+		t.Fatalf("carol should hear nothing, got %v", carol.last)
+	}
+}
+
+func TestSetMessageExpiry_Blocked(t *testing.T) {
+	s, _, carol := blockedGroupServer(t)
+
+	_, err := s.SetMessageExpiry(claimsContext("alice@example.com"), &v1.SetMessageExpiryRequest{
+		WithEmail: "carol@example.com",
+		Expiry:    v1.MessageExpiry_MESSAGE_EXPIRY_ONE_DAY,
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("SetMessageExpiry across a block: got %v, want PermissionDenied", err)
+	}
+	if carol.last != nil || len(s.msgs.(*fakeGroupMsgs).inserted) != 0 {
+		t.Fatal("no announcement should reach carol")
+	}
+}
+
+func TestRelayTyping_GroupSkipsBlocked(t *testing.T) {
+	s, bob, carol := blockedGroupServer(t)
+	group := s.groups.(*fakeGroups).group
+
+	s.relayTyping(context.Background(), "alice@example.com", &v1.TypingEvent{GroupId: group.ID.Hex()}, true)
+	if bob.last.GetTypingStarted() == nil || carol.last != nil {
+		t.Fatalf("expected only bob to see alice typing, bob=%v carol=%v", bob.last, carol.last)
+	}
+}
+
+func TestSplitBlocked(t *testing.T) {
+	blocks := &fakeBlocks{}
+	_, _ = blocks.Block(context.Background(), "alice@example.com", "bob@example.com")
+	_, _ = blocks.Block(context.Background(), "carol@example.com", "alice@example.com")
+	s := &Server{blocks: blocks}
+
+	unblocked, blocked, err := s.splitBlocked(context.Background(), "alice@example.com",
+		[]string{"alice@example.com", "bob@example.com", "carol@example.com", "dave@example.com"})
+	if err != nil {
+		t.Fatalf("splitBlocked failed: %v", err)
+	}
+	// blocks count in both directions
+	if !slices.Equal(unblocked, []string{"alice@example.com", "dave@example.com"}) ||
+		!slices.Equal(blocked, []string{"bob@example.com", "carol@example.com"}) {
+		t.Fatalf("unexpected split: %v, %v", unblocked, blocked)
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// chatEventBuffer is how many chat list changes may queue up for a WatchChats
+// stream before the hub evicts it
+const chatEventBuffer = 256
+
+// conversationBackfillBatch is how many conversation summaries
+// backfillConversations writes per round trip
+const conversationBackfillBatch = 500
+
+// WatchChats sends the caller's chat list like ListChats, then a synced marker,
+// then every change to it as messages are persisted, read or edited
+func (s *Server) WatchChats(req *v1.WatchChatsRequest, stream v1.ChatService_WatchChatsServer) error {
+	claims, ok := getClaimsFromContext(stream.Context())
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if s.hub == nil {
+		return status.Errorf(codes.Unavailable, "chat updates are not available")
+	}
+
+	// Subscribe before taking the snapshot so no change slips in between
+	events, cancel := s.hub.SubscribeChats(claims.Email, chatEventBuffer)
+	defer cancel()
+
+	var limit int64 = int64(req.GetLimit())
+	if limit == 0 {
+		limit = 50
+	}
+	chats, err := s.chatList(stream.Context(), claims.Email, limit)
+	if err != nil {
+		return err
+	}
+	for _, c := range chats {
+		if err := stream.Send(&v1.WatchChatsResponse{Event: &v1.WatchChatsResponse_Chat{Chat: c}}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send chat: %v", err)
+		}
+	}
+	if err := stream.Send(&v1.WatchChatsResponse{Event: &v1.WatchChatsResponse_Synced{Synced: &v1.ChatsSynced{}}}); err != nil {
+		return status.Errorf(codes.Internal, "failed to send chat: %v", err)
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.Aborted, "chat updates fell behind; watch again to resync")
+			}
+			resp, err := s.chatUpdate(stream.Context(), ev)
+			if err != nil {
+				return err
+			}
+			if resp == nil {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return status.Errorf(codes.Internal, "failed to send chat: %v", err)
+			}
+		}
+	}
+}
+
+// chatList returns email's conversations that have messages, most recently
+// active first, skipping groups they are no longer a member of and chats with
+// users they have a block with in either direction, like chatUpdate.
+func (s *Server) chatList(ctx context.Context, email string, limit int64) ([]*v1.ListChatsResponse, error) {
+	hidden, err := s.blockedEmails(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read blocked users: %v", err)
+	}
+
+	// Only groups the caller still belongs to are listed; group entries also
+	// need names
+	groups, err := s.groups.ListGroupsForUser(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read groups: %v", err)
+	}
+	groupIDs := make([]bson.ObjectID, 0, len(groups))
+	names := make(map[bson.ObjectID]string, len(groups))
+	for _, g := range groups {
+		groupIDs = append(groupIDs, g.ID)
+		names[g.ID] = g.Name
+	}
+
+	convs, err := s.convs.ListConversations(ctx, email, hidden, groupIDs, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read recent chats: %v", err)
+	}
+
+	// 1-on-1 entries show the partner's profile, loaded in one query
+	var partners []string
+	for _, c := range convs {
+		if c.GroupID.IsZero() {
+			partners = append(partners, c.PartnerEmail)
+		}
+	}
+	profiles := make(map[string]*data.User, len(partners))
+	if len(partners) > 0 {
+		users, err := s.users.GetUsersByEmails(ctx, partners)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to read profiles: %v", err)
+		}
+		for _, u := range users {
+			profiles[u.Email] = u
+		}
+	}
+
+	// Unread counts and last messages are maintained on every message, so no
+	// message scan is needed here
+	chats := make([]*v1.ListChatsResponse, 0, len(convs))
+	for _, c := range convs {
+		if !c.GroupID.IsZero() {
+			chats = append(chats, chatToProto(c, names[c.GroupID], nil))
+			continue
+		}
+		chats = append(chats, chatToProto(c, "", profiles[c.PartnerEmail]))
+	}
+	return chats, nil
+}
+
+// chatUpdate loads the conversation a ChatEvent refers to and converts it into
+// a WatchChats event. It returns nil when there is nothing to show, e.g. for a
+// group the watcher has since left.
+func (s *Server) chatUpdate(ctx context.Context, ev ChatEvent) (*v1.WatchChatsResponse, error) {
+	if ev.Removed {
+		return &v1.WatchChatsResponse{Event: &v1.WatchChatsResponse_RemovedGroupId{RemovedGroupId: ev.GroupID.Hex()}}, nil
+	}
+
+	var conv *data.Conversation
+	var groupName string
+	var partner *data.User
+	var err error
+	if ev.GroupID.IsZero() {
+		blocked, berr := s.blocked(ctx, ev.Email, ev.PartnerEmail)
+		if berr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", berr)
+		}
+		if blocked {
+			return nil, nil
+		}
+		partner, err = s.users.GetUserByEmail(ctx, ev.PartnerEmail)
+		if err != nil && !errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.Internal, "failed to load profile: %v", err)
+		}
+		conv, err = s.convs.GetConversation(ctx, ev.Email, ev.PartnerEmail)
+	} else {
+		group, gerr := s.groups.GetGroup(ctx, ev.GroupID)
+		if errors.Is(gerr, data.ErrGroupNotFound) {
+			return nil, nil
+		}
+		if gerr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load group: %v", gerr)
+		}
+		if !group.HasMember(ev.Email) {
+			return nil, nil
+		}
+		groupName = group.Name
+		conv, err = s.convs.GetGroupConversation(ctx, ev.Email, ev.GroupID)
+	}
+	if errors.Is(err, data.ErrConversationNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load conversation: %v", err)
+	}
+	if conv.LastMessageAt.IsZero() {
+		return nil, nil
+	}
+	return &v1.WatchChatsResponse{Event: &v1.WatchChatsResponse_Chat{Chat: chatToProto(conv, groupName, partner)}}, nil
+}
+
+// publishChats tells the chat list watchers of each email that their summary
+// of msg's conversation changed.
+func (s *Server) publishChats(msg *data.Message, emails []string) {
+	if s.hub == nil {
+		return
+	}
+	for _, email := range emails {
+		ev := ChatEvent{Email: email, GroupID: msg.GroupID}
+		if msg.GroupID.IsZero() {
+			ev.PartnerEmail = partnerOf(msg, email)
+		}
+		s.hub.PublishChat(ev)
+	}
+}
+
+// chatToProto converts a conversation summary into a chat list entry. partner
+// is the other user of a 1-on-1 chat, nil for groups or unknown users.
+func chatToProto(c *data.Conversation, groupName string, partner *data.User) *v1.ListChatsResponse {
+	resp := &v1.ListChatsResponse{
+		Email:         c.PartnerEmail,
+		LastMessage:   c.LastMessage,
+		LastMessageAt: timestamppb.New(c.LastMessageAt),
+		GroupId:       groupIDHex(c.GroupID),
+		GroupName:     groupName,
+		UnreadCount:   int32(c.UnreadCount),
+	}
+	if c.GroupID.IsZero() {
+		resp.MessageExpiry = expiryFromSeconds(c.ExpirySeconds)
+		resp.Profile = profileSnippet(partner)
+	}
+	// A disappeared last message leaves no preview behind
+	if !c.LastExpiresAt.IsZero() && !c.LastExpiresAt.After(time.Now()) {
+		resp.LastMessage = ""
+	}
+	return resp
+}
+
+// backfillConversations creates the conversation summaries of chats that
+// predate the conversations collection, so ListChats and the first contact
+// gate keep seeing them. It returns how many conversations were filled in.
+func backfillConversations(ctx context.Context, msgs MessagesStore, convs ConversationsStore) (int64, error) {
+	var total int64
+	batch := make([]*data.Conversation, 0, conversationBackfillBatch)
+	flush := func() error {
+		n, err := convs.Backfill(ctx, batch)
+		total += n
+		batch = batch[:0]
+		return err
+	}
+
+	err := msgs.ConversationSummaries(ctx, func(c *data.Conversation) error {
+		batch = append(batch, c)
+		if len(batch) < conversationBackfillBatch {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil && len(batch) > 0 {
+		err = flush()
+	}
+	return total, err
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fakeSummaries serves ConversationSummaries and GetMessageHistory from fixed lists.
+type fakeSummaries struct {
+	MessagesStore
+	summaries []*data.Conversation
+	history   []*data.Message
+}
+
+func (f *fakeSummaries) ConversationSummaries(_ context.Context, fn func(*data.Conversation) error) error {
+	for _, c := range f.summaries {
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeSummaries) GetMessageHistory(context.Context, string, string, *data.HistoryCursor, int64) ([]*data.Message, error) {
+	return f.history, nil
+}
+
+// fakeBackfill records Backfill batches and ReplaceLastMessage calls.
+type fakeBackfill struct {
+	ConversationsStore
+	batches  []int
+	replaced map[string]*data.Message
+}
+
+func (f *fakeBackfill) Backfill(_ context.Context, summaries []*data.Conversation) (int64, error) {
+	f.batches = append(f.batches, len(summaries))
+	return int64(len(summaries)), nil
+}
+
+func (f *fakeBackfill) ReplaceLastMessage(_ context.Context, owner string, _ bson.ObjectID, latest *data.Message) (bool, error) {
+	if f.replaced == nil {
+		f.replaced = make(map[string]*data.Message)
+	}
+	f.replaced[owner] = latest
+	return true, nil
+}
+
+func TestBackfillConversations_Batches(t *testing.T) {
+	msgs := &fakeSummaries{}
+	for range conversationBackfillBatch + 3 {
+		msgs.summaries = append(msgs.summaries, &data.Conversation{OwnerEmail: "alice@example.com"})
+	}
+	convs := &fakeBackfill{}
+
+	n, err := backfillConversations(context.Background(), msgs, convs)
+	if err != nil {
+		t.Fatalf("backfillConversations failed: %v", err)
+	}
+	if n != int64(len(msgs.summaries)) {
+		t.Fatalf("expected %d conversations filled in, got %d", len(msgs.summaries), n)
+	}
+	if len(convs.batches) != 2 || convs.batches[0] != conversationBackfillBatch || convs.batches[1] != 3 {
+		t.Fatalf("unexpected batches: %v", convs.batches)
+	}
+}
+
+func TestHidePreview(t *testing.T) {
+	older := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "older"}
+	hidden := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "hidden"}
+	msgs := &fakeSummaries{history: []*data.Message{older}}
+	convs := &fakeBackfill{}
+	s := &Server{msgs: msgs, convs: convs}
+
+	// the preview falls back to the newest message bob can still see
+	s.hidePreview(context.Background(), hidden, "bob@example.com")
+	if got := convs.replaced["bob@example.com"]; got != older {
+		t.Fatalf("expected bob's preview to fall back to the older message, got %+v", got)
+	}
+	if _, ok := convs.replaced["alice@example.com"]; ok {
+		t.Fatal("alice's preview should be untouched")
+	}
+
+	// with nothing left the preview is cleared
+	msgs.history = nil
+	s.hidePreview(context.Background(), older, "bob@example.com")
+	if got, ok := convs.replaced["bob@example.com"]; !ok || got != nil {
+		t.Fatalf("expected bob's preview to be cleared, got %+v", got)
+	}
+}
+
+// fakeChatList is a ConversationsStore whose ListConversations filters a fixed
+// list, newest first, the way the query does.
+type fakeChatList struct {
+	ConversationsStore
+	convs []*data.Conversation
+}
+
+func (f *fakeChatList) ListConversations(_ context.Context, _ string, hidden []string, groupIDs []bson.ObjectID, limit int64) ([]*data.Conversation, error) {
+	var convs []*data.Conversation
+	for _, c := range f.convs {
+		if c.GroupID.IsZero() && slices.Contains(hidden, c.PartnerEmail) ||
+			!c.GroupID.IsZero() && !slices.Contains(groupIDs, c.GroupID) {
+			continue
+		}
+		if int64(len(convs)) < limit {
+			convs = append(convs, c)
+		}
+	}
+	return convs, nil
+}
+
+func TestChatList(t *testing.T) {
+	ctx := context.Background()
+	blocks := &fakeBlocks{}
+	// carol blocked alice: the chat is hidden from alice too, like in WatchChats
+	_, _ = blocks.Block(ctx, "carol@example.com", "alice@example.com")
+	team := &data.Group{ID: bson.NewObjectID(), Name: "team", Members: []string{"alice@example.com"}}
+	left := bson.NewObjectID()
+	convs := &fakeChatList{convs: []*data.Conversation{
+		{OwnerEmail: "alice@example.com", PartnerEmail: "carol@example.com"},
+		{OwnerEmail: "alice@example.com", GroupID: left},
+		{OwnerEmail: "alice@example.com", GroupID: team.ID},
+		{OwnerEmail: "alice@example.com", PartnerEmail: "bob@example.com"},
+	}}
+	s := &Server{blocks: blocks, convs: convs, groups: &fakeGroups{group: team}, users: &fakeUsers{}}
+
+	// neither the blocked chat nor the left group uses up the page
+	chats, err := s.chatList(ctx, "alice@example.com", 2)
+	if err != nil {
+		t.Fatalf("chatList failed: %v", err)
+	}
+	if len(chats) != 2 || chats[0].GetGroupName() != "team" || chats[1].GetEmail() != "bob@example.com" {
+		t.Fatalf("unexpected chats: %v", chats)
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// redeliveryLimit caps how many unacknowledged messages are replayed when a
+	// stream connects; the rest follow on the next reconnect.
+	redeliveryLimit = 500
+	// maxAckIDs matches the max_items bound declared in the proto, which is
+	// also enough to ack a full redelivery in one frame
+	maxAckIDs = 500
+	// resumePageSize is how many messages a resume replay loads per query.
+	resumePageSize = 500
+	// resumeGrace widens a resume cursor backwards. Message IDs are generated by
+	// each server before the insert lands, so a message can become visible just
+	// after a newer one the client already has; the overlap is deduped by msg_id.
+	resumeGrace = 5 * time.Second
+)
+
+// redeliver replays every message email has not acknowledged yet onto a newly
+// connected stream and returns the IDs it sent. Group messages from before
+// email joined aren't replayed. Live messages may arrive twice; clients dedupe
+// by msg_id.
+func (s *Server) redeliver(stream v1.ChatService_ChatStreamServer, email string) (map[bson.ObjectID]bool, error) {
+	ctx := stream.Context()
+
+	groups, err := s.groups.ListGroupsForUser(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+
+	msgs, err := s.msgs.GetUndelivered(ctx, email, groups, redeliveryLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load undelivered messages: %v", err)
+	}
+	sent := make(map[bson.ObjectID]bool, len(msgs))
+	for _, m := range msgs {
+		if err := stream.Send(streamResponse(m)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to redeliver message: %v", err)
+		}
+		sent[m.ID] = true
+	}
+	return sent, nil
+}
+
+// resume replays every message in email's conversations after the message
+// resumeAfter, except those redeliver already sent, then ends the replay with
+// a ResumeComplete event. Live delivery keeps running meanwhile, so clients
+// dedupe by msg_id as with redelivery.
+func (s *Server) resume(stream v1.ChatService_ChatStreamServer, email, resumeAfter string, redelivered map[bson.ObjectID]bool) error {
+	ctx := stream.Context()
+
+	// The cursor is only a position: it needn't still exist or be visible to email
+	cursorID, err := bson.ObjectIDFromHex(resumeAfter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid resume_after")
+	}
+	ids, err := s.memberGroupIDs(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	after := bson.NewObjectIDFromTimestamp(cursorID.Timestamp().Add(-resumeGrace))
+	var replayed int32
+	for {
+		msgs, err := s.msgs.GetInboxSince(ctx, email, ids, after, resumePageSize)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load missed messages: %v", err)
+		}
+		for _, m := range msgs {
+			after = m.ID
+			if m.ID == cursorID || redelivered[m.ID] {
+				continue
+			}
+			if err := stream.Send(streamResponse(m)); err != nil {
+				return status.Errorf(codes.Internal, "failed to replay message: %v", err)
+			}
+			replayed++
+		}
+		if len(msgs) < resumePageSize {
+			break
+		}
+	}
+
+	err = stream.Send(&v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_ResumeComplete{
+			ResumeComplete: &v1.ResumeComplete{Replayed: replayed},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to complete resume: %v", err)
+	}
+	return nil
+}
+
+// memberGroupIDs returns the IDs of every group email currently belongs to.
+func (s *Server) memberGroupIDs(ctx context.Context, email string) ([]bson.ObjectID, error) {
+	groups, err := s.groups.ListGroupsForUser(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	ids := make([]bson.ObjectID, 0, len(groups))
+	for _, g := range groups {
+		ids = append(ids, g.ID)
+	}
+	return ids, nil
+}
+
+// ackDelivered marks the given messages as delivered to email and relays a
+// delivery receipt to each sender. Bad or foreign IDs are logged and skipped so
+// one stale ack can't break the stream.
+func (s *Server) ackDelivered(ctx context.Context, email string, msgIDs []string) {
+	for _, hex := range msgIDs {
+		id, err := bson.ObjectIDFromHex(hex)
+		if err != nil {
+			log.Printf("ignoring ack for invalid msg_id %q from %s", hex, email)
+			continue
+		}
+
+		msg, err := s.msgs.GetMessage(ctx, id)
+		if err != nil {
+			log.Printf("ignoring ack for %s from %s: %v", hex, email, err)
+			continue
+		}
+		emails, err := s.participants(ctx, msg)
+		if err != nil {
+			log.Printf("failed to resolve participants of %s: %v", hex, err)
+			continue
+		}
+		if !slices.Contains(emails, email) {
+			log.Printf("ignoring ack for %s from non-participant %s", hex, email)
+			continue
+		}
+
+		msg, err = s.msgs.MarkDelivered(ctx, id, email)
+		if err != nil {
+			// Already acknowledged (or the caller's own message): nothing to relay
+			if !errors.Is(err, data.ErrMessageNotFound) {
+				log.Printf("failed to mark %s delivered to %s: %v", hex, email, err)
+			}
+			continue
+		}
+
+		if s.hub != nil && s.hub.IsOnline(msg.FromEmail) {
+			resp := &v1.ChatStreamResponse{
+				Event: &v1.ChatStreamResponse_DeliveryReceipt{
+					DeliveryReceipt: &v1.DeliveryReceipt{
+						RecipientEmail: email,
+						MsgId:          msg.ID.Hex(),
+						GroupId:        groupIDHex(msg.GroupID),
+						DeliveredAt:    timestamppb.New(time.Now()),
+					},
+				},
+			}
+			if err := s.hub.SendToUser(msg.FromEmail, resp); err != nil {
+				log.Printf("delivery receipt to %s failed: %v", msg.FromEmail, err)
+			}
+		}
+	}
+}
+
+// deliveryState derives m's delivery progress from the participants' acks and
+// read watermarks.
+func deliveryState(m *data.Message, participants []string, watermarks readWatermarks) v1.DeliveryState {
+	if watermarks.readBy(m, participants) {
+		return v1.DeliveryState_DELIVERY_STATE_READ
+	}
+	for _, email := range participants {
+		if email != m.FromEmail && !slices.Contains(m.DeliveredTo, email) {
+			return v1.DeliveryState_DELIVERY_STATE_SENT
+		}
+	}
+	return v1.DeliveryState_DELIVERY_STATE_DELIVERED
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+)
+
+func TestDeliveryState(t *testing.T) {
+	sentAt := time.Now()
+	members := []string{"alice@example.com", "bob@example.com", "carol@example.com"}
+	msg := &data.Message{FromEmail: "alice@example.com", SentAt: sentAt}
+
+	if got := deliveryState(msg, members, readWatermarks{}); got != v1.DeliveryState_DELIVERY_STATE_SENT {
+		t.Fatalf("expected SENT without acks, got %v", got)
+	}
+
+	// one member acking isn't enough for a group
+	msg.DeliveredTo = []string{"bob@example.com"}
+	if got := deliveryState(msg, members, readWatermarks{}); got != v1.DeliveryState_DELIVERY_STATE_SENT {
+		t.Fatalf("expected SENT with partial acks, got %v", got)
+	}
+
+	msg.DeliveredTo = []string{"bob@example.com", "carol@example.com"}
+	if got := deliveryState(msg, members, readWatermarks{}); got != v1.DeliveryState_DELIVERY_STATE_DELIVERED {
+		t.Fatalf("expected DELIVERED, got %v", got)
+	}
+
+	read := readWatermarks{"bob@example.com": sentAt, "carol@example.com": sentAt.Add(time.Second)}
+	if got := deliveryState(msg, members, read); got != v1.DeliveryState_DELIVERY_STATE_READ {
+		t.Fatalf("expected READ, got %v", got)
+	}
+}
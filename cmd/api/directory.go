@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSearchUsersLimit is the page size when SearchUsersRequest.limit is unset.
+	defaultSearchUsersLimit = 20
+	// maxSearchUsersLimit matches the limit bound declared in the proto
+	maxSearchUsersLimit = 50
+)
+
+// SearchUsers finds discoverable users whose email or display name starts
+// with the query. The caller and users blocked either way are never returned
+func (s *Server) SearchUsers(ctx context.Context, req *v1.SearchUsersRequest) (*v1.SearchUsersResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	// Keyed by caller rather than peer so one account can't walk the
+	// directory from many connections
+	if s.searchLimiter != nil && !s.searchLimiter.Allow("search:"+claims.Email) {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	query := strings.TrimSpace(req.GetQuery())
+	if len(query) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "query must be at least 2 characters")
+	}
+	// The proto bounds aren't enforced by any interceptor, and a negative
+	// limit would disable the query limit altogether
+	limit := int64(req.GetLimit())
+	if limit < 0 || limit > maxSearchUsersLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxSearchUsersLimit)
+	}
+	if limit == 0 {
+		limit = defaultSearchUsersLimit
+	}
+
+	// Users with a block either way never show up
+	hidden, err := s.blockedEmails(ctx, claims.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read blocked users: %v", err)
+	}
+	users, err := s.users.SearchUsers(ctx, data.UserSearch{
+		EmailPrefix: query,
+		// Display names are stored escaped, so match them escaped
+		NamePrefix: html.EscapeString(query),
+		Exclude:    append(hidden, claims.Email),
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
+	}
+
+	resp := &v1.SearchUsersResponse{Users: make([]*v1.Profile, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, profileToProto(u))
+	}
+	return resp, nil
+}
+
+// SetDiscoverable updates whether the caller shows up in SearchUsers results
+func (s *Server) SetDiscoverable(ctx context.Context, req *v1.SetDiscoverableRequest) (*v1.SetDiscoverableResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	if err := s.users.SetDiscoverable(ctx, claims.Email, req.GetDiscoverable()); err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update discoverability: %v", err)
+	}
+
+	return &v1.SetDiscoverableResponse{Discoverable: req.GetDiscoverable()}, nil
+}
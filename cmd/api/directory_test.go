@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/middleware"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUsers is a UsersStore whose SearchUsers returns a fixed result minus
+// the excluded emails and records the query.
+type fakeUsers struct {
+	UsersStore
+	found []*data.User
+	last  data.UserSearch
+}
+
+func (f *fakeUsers) SearchUsers(_ context.Context, q data.UserSearch) ([]*data.User, error) {
+	f.last = q
+	var users []*data.User
+	for _, u := range f.found {
+		if !slices.Contains(q.Exclude, u.Email) {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (f *fakeUsers) GetUsersByEmails(_ context.Context, emails []string) ([]*data.User, error) {
+	var users []*data.User
+	for _, u := range f.found {
+		if slices.Contains(emails, u.Email) {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func TestSearchUsers(t *testing.T) {
+	users := &fakeUsers{found: []*data.User{{Email: "bob@example.com"}, {Email: "carol@example.com"}}}
+	blocks := &fakeBlocks{}
+	_, _ = blocks.Block(context.Background(), "carol@example.com", "alice@example.com")
+	limiter := middleware.NewLimiterStore(60, 2, time.Minute)
+	defer limiter.Stop()
+	s := &Server{users: users, blocks: blocks, searchLimiter: limiter}
+	ctx := context.WithValue(context.Background(), authContextKey{}, &auth.Claims{Email: "alice@example.com"})
+
+	resp, err := s.SearchUsers(ctx, &v1.SearchUsersRequest{Query: " O'Br "})
+	if err != nil {
+		t.Fatalf("SearchUsers failed: %v", err)
+	}
+	// carol blocked alice, so only bob is returned
+	if len(resp.GetUsers()) != 1 || resp.GetUsers()[0].GetEmail() != "bob@example.com" {
+		t.Fatalf("unexpected results: %v", resp.GetUsers())
+	}
+	if users.last.EmailPrefix != "O'Br" || users.last.NamePrefix != "O&#39;Br" {
+		t.Fatalf("unexpected prefixes: %+v", users.last)
+	}
+	if users.last.Limit != defaultSearchUsersLimit || !slices.Equal(users.last.Exclude, []string{"carol@example.com", "alice@example.com"}) {
+		t.Fatalf("unexpected query: %+v", users.last)
+	}
+
+	// the burst of 2 is used up by the next call
+	if _, err := s.SearchUsers(ctx, &v1.SearchUsersRequest{Query: "bo"}); err != nil {
+		t.Fatalf("SearchUsers failed: %v", err)
+	}
+	if _, err := s.SearchUsers(ctx, &v1.SearchUsersRequest{Query: "bo"}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestSearchUsers_Limit(t *testing.T) {
+	users := &fakeUsers{}
+	s := &Server{users: users, blocks: &fakeBlocks{}}
+	ctx := context.WithValue(context.Background(), authContextKey{}, &auth.Claims{Email: "alice@example.com"})
+
+	for _, limit := range []int32{-1, maxSearchUsersLimit + 1} {
+		if _, err := s.SearchUsers(ctx, &v1.SearchUsersRequest{Query: "bo", Limit: limit}); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("limit %d: got %v, want InvalidArgument", limit, err)
+		}
+	}
+	if _, err := s.SearchUsers(ctx, &v1.SearchUsersRequest{Query: "bo", Limit: maxSearchUsersLimit}); err != nil {
+		t.Fatalf("SearchUsers failed: %v", err)
+	}
+	if users.last.Limit != maxSearchUsersLimit {
+		t.Fatalf("Limit = %d, want %d", users.last.Limit, maxSearchUsersLimit)
+	}
+}
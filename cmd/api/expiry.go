@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expiryDurations maps each supported setting to how long messages are kept;
+// zero means forever.
+var expiryDurations = map[v1.MessageExpiry]time.Duration{
+	v1.MessageExpiry_MESSAGE_EXPIRY_OFF:        0,
+	v1.MessageExpiry_MESSAGE_EXPIRY_ONE_HOUR:   time.Hour,
+	v1.MessageExpiry_MESSAGE_EXPIRY_ONE_DAY:    24 * time.Hour,
+	v1.MessageExpiry_MESSAGE_EXPIRY_SEVEN_DAYS: 7 * 24 * time.Hour,
+}
+
+// expiryLabels names each duration in announcements.
+var expiryLabels = map[v1.MessageExpiry]string{
+	v1.MessageExpiry_MESSAGE_EXPIRY_ONE_HOUR:   "1 hour",
+	v1.MessageExpiry_MESSAGE_EXPIRY_ONE_DAY:    "1 day",
+	v1.MessageExpiry_MESSAGE_EXPIRY_SEVEN_DAYS: "7 days",
+}
+
+// SetMessageExpiry changes the disappearing messages setting of the caller's
+// 1-on-1 chat with with_email. The setting applies to messages sent afterwards
+// and a system message announcing it is posted to both participants.
+func (s *Server) SetMessageExpiry(ctx context.Context, req *v1.SetMessageExpiryRequest) (*v1.SetMessageExpiryResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	ttl, ok := expiryDurations[req.GetExpiry()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported expiry")
+	}
+	partner := normalize.Email(req.GetWithEmail())
+	if partner == claims.Email {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot set expiry on a chat with yourself")
+	}
+
+	exists, err := s.users.UserExists(ctx, partner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify user: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	// The announcement reaches the partner, so blocks apply as for messages
+	if err := s.requireUnblocked(ctx, claims.Email, partner); err != nil {
+		return nil, err
+	}
+
+	// The announcement would reach a stranger's chat list, so the chat has to be accepted first
+	pending, err := s.messageGate(ctx, claims.Email, partner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check contacts: %v", err)
+	}
+	if pending {
+		return nil, status.Errorf(codes.FailedPrecondition, "disappearing messages can be set once %s accepts your messages", partner)
+	}
+
+	resp := &v1.SetMessageExpiryResponse{WithEmail: partner, Expiry: req.GetExpiry()}
+	seconds := int64(ttl / time.Second)
+	conv, err := s.convs.GetConversation(ctx, claims.Email, partner)
+	switch {
+	case err == nil && conv.ExpirySeconds == seconds:
+		// Nothing changed, so there is nothing to announce
+		return resp, nil
+	case err != nil && !errors.Is(err, data.ErrConversationNotFound):
+		return nil, status.Errorf(codes.Internal, "failed to load conversation: %v", err)
+	case err != nil && seconds == 0:
+		// A chat that never had a setting is already off
+		return resp, nil
+	}
+
+	if err := s.convs.SetExpiry(ctx, claims.Email, partner, seconds); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update expiry: %v", err)
+	}
+
+	participants := []string{claims.Email, partner}
+	saved, _, err := s.saveMessage(ctx, &data.Message{
+		FromEmail: claims.Email,
+		ToEmail:   partner,
+		Content:   expiryNotice(claims.Email, req.GetExpiry()),
+		SentAt:    time.Now(),
+		System:    true,
+	}, participants)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to announce expiry: %v", err)
+	}
+	resp.AnnouncementMsgId = saved.ID.Hex()
+
+	// Both participants see the announcement, including the caller's other devices
+	if s.hub != nil {
+		if err := s.hub.SendToUsers(participants, streamResponse(saved)); err != nil {
+			log.Printf("expiry announcement %s incomplete: %v", saved.ID.Hex(), err)
+		}
+	}
+	return resp, nil
+}
+
+// messageExpiry returns when a 1-on-1 message from fromEmail to toEmail sent
+// at sentAt should disappear, or the zero time if the chat keeps messages.
+func (s *Server) messageExpiry(ctx context.Context, fromEmail, toEmail string, sentAt time.Time) (time.Time, error) {
+	conv, err := s.convs.GetConversation(ctx, fromEmail, toEmail)
+	if err != nil {
+		if errors.Is(err, data.ErrConversationNotFound) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if conv.ExpirySeconds <= 0 {
+		return time.Time{}, nil
+	}
+	return sentAt.Add(time.Duration(conv.ExpirySeconds) * time.Second), nil
+}
+
+// expiryFromSeconds maps a stored setting back to its API value; durations no
+// setting uses map to UNSPECIFIED.
+func expiryFromSeconds(seconds int64) v1.MessageExpiry {
+	for expiry, ttl := range expiryDurations {
+		if int64(ttl/time.Second) == seconds {
+			return expiry
+		}
+	}
+	return v1.MessageExpiry_MESSAGE_EXPIRY_UNSPECIFIED
+}
+
+// expiryNotice is the content of the system message announcing a new setting.
+func expiryNotice(email string, expiry v1.MessageExpiry) string {
+	who := html.EscapeString(email)
+	label, ok := expiryLabels[expiry]
+	if !ok {
+		return fmt.Sprintf("%s turned off disappearing messages", who)
+	}
+	return fmt.Sprintf("%s turned on disappearing messages. New messages will disappear %s after they are sent", who, label)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+)
+
+func TestExpirySettings(t *testing.T) {
+	for expiry, ttl := range expiryDurations {
+		if got := expiryFromSeconds(int64(ttl / time.Second)); got != expiry {
+			t.Errorf("expiryFromSeconds(%v) = %v, want %v", ttl, got, expiry)
+		}
+	}
+	if got := expiryFromSeconds(42); got != v1.MessageExpiry_MESSAGE_EXPIRY_UNSPECIFIED {
+		t.Errorf("unknown duration mapped to %v", got)
+	}
+
+	if got := expiryNotice("alice@example.com", v1.MessageExpiry_MESSAGE_EXPIRY_OFF); got != "alice@example.com turned off disappearing messages" {
+		t.Errorf("unexpected off notice %q", got)
+	}
+	if got := expiryNotice("alice@example.com", v1.MessageExpiry_MESSAGE_EXPIRY_ONE_DAY); !strings.Contains(got, "turned on") || !strings.Contains(got, "1 day") {
+		t.Errorf("unexpected on notice %q", got)
+	}
+}
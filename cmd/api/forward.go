@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxForwardTargets matches the max_items bound declared in the proto on both
+// to_emails and group_ids
+const maxForwardTargets = 10
+
+// forwardTarget is one conversation a message is forwarded to: a 1-on-1 chat
+// with toEmail or a group.
+type forwardTarget struct {
+	toEmail string
+	group   *data.Group
+}
+
+// ForwardMessage copies a message from one of the caller's conversations to
+// each requested user and group, keeping a reference to the original. Every
+// copy is stored and delivered like a freshly sent message, including to the
+// caller's own devices.
+func (s *Server) ForwardMessage(ctx context.Context, req *v1.ForwardMessageRequest) (*v1.ForwardMessageResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if len(req.GetToEmails())+len(req.GetGroupIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "to_emails or group_ids is required")
+	}
+	// The proto bounds aren't enforced by any interceptor, and every target
+	// is a stored message plus a fan-out
+	if len(req.GetToEmails()) > maxForwardTargets || len(req.GetGroupIds()) > maxForwardTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d to_emails and %d group_ids", maxForwardTargets, maxForwardTargets)
+	}
+
+	msg, err := s.loadMessage(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+	emails, err := s.participants(ctx, msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+	}
+	if !slices.Contains(emails, claims.Email) {
+		return nil, status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+	}
+	// Messages the caller deleted for themselves, can't see because of a
+	// block, or hasn't accepted as a request are as good as missing
+	hidden := slices.Contains(msg.HiddenFor, claims.Email) || (msg.Pending && msg.ToEmail == claims.Email)
+	if hidden || (!msg.ExpiresAt.IsZero() && !msg.ExpiresAt.After(time.Now())) {
+		return nil, status.Errorf(codes.NotFound, "message not found")
+	}
+	if !msg.DeletedAt.IsZero() {
+		return nil, status.Errorf(codes.FailedPrecondition, "deleted messages cannot be forwarded")
+	}
+	if msg.System {
+		return nil, status.Errorf(codes.FailedPrecondition, "system messages cannot be forwarded")
+	}
+
+	// Check every target before storing anything so one bad target fails the
+	// whole request instead of leaving it half done
+	targets, err := s.forwardTargets(ctx, claims.Email, req)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := forwardOrigin(msg)
+	now := time.Now()
+	resp := &v1.ForwardMessageResponse{Messages: make([]*v1.ChatMessage, 0, len(targets))}
+	for _, t := range targets {
+		fwd := &data.Message{
+			FromEmail:     claims.Email,
+			Content:       msg.Content,
+			SentAt:        now,
+			Attachments:   msg.Attachments,
+			ForwardedFrom: origin,
+		}
+		var participants []string
+		if t.group != nil {
+			fwd.GroupID = t.group.ID
+			participants, fwd.HiddenFor, err = s.splitBlocked(ctx, claims.Email, t.group.Members)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+			}
+		} else {
+			fwd.ToEmail = t.toEmail
+			fwd.Pending, err = s.messageGate(ctx, claims.Email, t.toEmail)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check contacts: %v", err)
+			}
+			participants = []string{claims.Email, t.toEmail}
+			if fwd.Pending {
+				participants = []string{claims.Email}
+			}
+			fwd.ExpiresAt, err = s.messageExpiry(ctx, claims.Email, t.toEmail, now)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to load conversation: %v", err)
+			}
+		}
+
+		saved, _, err := s.saveMessage(ctx, fwd, participants)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save message: %v", err)
+		}
+		if saved.Pending {
+			s.recordRequest(ctx, saved)
+		}
+		out := streamResponse(saved)
+		if s.hub != nil {
+			if err := s.hub.SendToUsers(participants, out); err != nil {
+				log.Printf("delivery of forward %s incomplete: %v", saved.ID.Hex(), err)
+			}
+		}
+		resp.Messages = append(resp.Messages, out.GetMessage())
+	}
+	return resp, nil
+}
+
+// forwardTargets resolves the users and groups of a forward request, skipping
+// duplicates. Users must exist and the caller must belong to every group.
+func (s *Server) forwardTargets(ctx context.Context, caller string, req *v1.ForwardMessageRequest) ([]forwardTarget, error) {
+	var targets []forwardTarget
+	seen := make(map[string]bool)
+	for _, e := range req.GetToEmails() {
+		email := normalize.Email(e)
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		if email == caller {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot forward a message to yourself")
+		}
+		exists, err := s.users.UserExists(ctx, email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to verify recipient: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "recipient %s not found", email)
+		}
+		blocked, err := s.blocked(ctx, caller, email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+		}
+		if blocked {
+			return nil, status.Errorf(codes.PermissionDenied, "messages between you and %s are blocked", email)
+		}
+		targets = append(targets, forwardTarget{toEmail: email})
+	}
+	for _, id := range req.GetGroupIds() {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		group, err := s.memberGroup(ctx, id, caller)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, forwardTarget{group: group})
+	}
+	return targets, nil
+}
+
+// forwardOrigin returns the forwarded-from reference for a copy of m: m itself,
+// or m's own origin when m is already a forward.
+func forwardOrigin(m *data.Message) *data.ForwardedMessage {
+	if m.ForwardedFrom != nil {
+		origin := *m.ForwardedFrom
+		return &origin
+	}
+	return &data.ForwardedMessage{MsgID: m.ID, FromEmail: m.FromEmail, SentAt: m.SentAt}
+}
+
+// forwardedToProto converts a forwarded-from reference into its API representation.
+func forwardedToProto(f *data.ForwardedMessage) *v1.ForwardedFrom {
+	if f == nil {
+		return nil
+	}
+	return &v1.ForwardedFrom{
+		MsgId:     f.MsgID.Hex(),
+		FromEmail: f.FromEmail,
+		SentAt:    timestamppb.New(f.SentAt),
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestForwardOrigin(t *testing.T) {
+	sent := time.Now().Add(-time.Hour)
+	original := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", SentAt: sent}
+
+	origin := forwardOrigin(original)
+	if origin.MsgID != original.ID || origin.FromEmail != "alice@example.com" || !origin.SentAt.Equal(sent) {
+		t.Fatalf("unexpected origin: %+v", origin)
+	}
+
+	// forwarding a forward keeps pointing at the original
+	forward := &data.Message{ID: bson.NewObjectID(), FromEmail: "bob@example.com", SentAt: time.Now(), ForwardedFrom: origin}
+	again := forwardOrigin(forward)
+	if *again != *origin || again == origin {
+		t.Fatalf("expected a copy of the original origin, got %+v", again)
+	}
+
+	if got := forwardedToProto(again); got.GetMsgId() != original.ID.Hex() || got.GetFromEmail() != "alice@example.com" {
+		t.Fatalf("unexpected proto: %+v", got)
+	}
+	if forwardedToProto(nil) != nil {
+		t.Fatal("expected nil for messages that aren't forwards")
+	}
+}
+
+func TestForwardMessage_HiddenFromCaller(t *testing.T) {
+	s, _, _ := blockedGroupServer(t)
+	group := s.groups.(*fakeGroups).group
+	msgs := s.msgs.(*fakeGroupMsgs)
+
+	// carol blocked alice, so alice's group message is hidden for carol
+	msgs.msg = &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", GroupID: group.ID, Content: "secret", HiddenFor: []string{"carol@example.com"}}
+	req := &v1.ForwardMessageRequest{MsgId: msgs.msg.ID.Hex(), GroupIds: []string{group.ID.Hex()}}
+	if _, err := s.ForwardMessage(claimsContext("carol@example.com"), req); status.Code(err) != codes.NotFound {
+		t.Fatalf("forwarding a hidden message: got %v, want NotFound", err)
+	}
+
+	// an unaccepted request can't be forwarded by its recipient
+	msgs.msg = &data.Message{ID: bson.NewObjectID(), FromEmail: "dave@example.com", ToEmail: "bob@example.com", Content: "hi", Pending: true}
+	req = &v1.ForwardMessageRequest{MsgId: msgs.msg.ID.Hex(), GroupIds: []string{group.ID.Hex()}}
+	if _, err := s.ForwardMessage(claimsContext("bob@example.com"), req); status.Code(err) != codes.NotFound {
+		t.Fatalf("forwarding a pending request: got %v, want NotFound", err)
+	}
+	if len(msgs.inserted) != 0 {
+		t.Fatalf("expected nothing to be forwarded, got %+v", msgs.inserted)
+	}
+
+	// targets are bounded
+	emails := strings.Split(strings.Repeat("x@example.com,", maxForwardTargets+1), ",")[:maxForwardTargets+1]
+	if _, err := s.ForwardMessage(claimsContext("bob@example.com"), &v1.ForwardMessageRequest{MsgId: msgs.msg.ID.Hex(), ToEmails: emails}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("too many targets: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateGroup creates a group owned by the caller after checking every member
+// exists and has no block with the caller. Members who don't take messages
+// from the caller are invited instead.
+func (s *Server) CreateGroup(ctx context.Context, req *v1.CreateGroupRequest) (*v1.CreateGroupResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	for _, email := range req.GetMemberEmails() {
+		exists, err := s.users.UserExists(ctx, email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to verify member: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "member %s not found", email)
+		}
+		if err := s.requireUnblocked(ctx, claims.Email, normalize.Email(email)); err != nil {
+			return nil, err
+		}
+	}
+
+	var members, invitees []string
+	for _, email := range req.GetMemberEmails() {
+		email = normalize.Email(email)
+		if email == claims.Email {
+			continue
+		}
+		pending, err := s.messageGate(ctx, claims.Email, email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check contacts: %v", err)
+		}
+		if pending {
+			invitees = append(invitees, email)
+		} else {
+			members = append(members, email)
+		}
+	}
+
+	group, err := s.groups.CreateGroup(ctx, req.GetName(), claims.Email, members)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	for _, email := range invitees {
+		if group, err = s.inviteToGroup(ctx, group, claims.Email, email); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.CreateGroupResponse{Group: groupToProto(group)}, nil
+}
+
+// AddMember adds an existing user without a block with the caller to a group
+// owned by the caller, or invites them if they don't take messages from the
+// caller
+func (s *Server) AddMember(ctx context.Context, req *v1.AddMemberRequest) (*v1.AddMemberResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	group, err := s.loadGroup(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	if group.OwnerEmail != claims.Email {
+		return nil, status.Errorf(codes.PermissionDenied, "only the group owner can add members")
+	}
+
+	exists, err := s.users.UserExists(ctx, req.GetEmail())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify member: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	email := normalize.Email(req.GetEmail())
+	if err := s.requireUnblocked(ctx, claims.Email, email); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.messageGate(ctx, claims.Email, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check contacts: %v", err)
+	}
+	if pending {
+		group, err = s.inviteToGroup(ctx, group, claims.Email, email)
+		if err != nil {
+			return nil, err
+		}
+		return &v1.AddMemberResponse{Group: groupToProto(group)}, nil
+	}
+
+	group, err = s.groups.AddMember(ctx, group.ID, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add member: %v", err)
+	}
+
+	return &v1.AddMemberResponse{Group: groupToProto(group)}, nil
+}
+
+// RemoveMember removes a member from a group owned by the caller, or
+// withdraws their invite
+func (s *Server) RemoveMember(ctx context.Context, req *v1.RemoveMemberRequest) (*v1.RemoveMemberResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	group, err := s.loadGroup(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	if group.OwnerEmail != claims.Email {
+		return nil, status.Errorf(codes.PermissionDenied, "only the group owner can remove members")
+	}
+
+	email := normalize.Email(req.GetEmail())
+	if email == claims.Email {
+		return nil, status.Errorf(codes.InvalidArgument, "use LeaveGroup to leave your own group")
+	}
+	if !group.HasMember(email) && !group.HasInvite(email) {
+		return nil, status.Errorf(codes.NotFound, "%s is not a member of this group", email)
+	}
+
+	group, err = s.groups.RemoveMember(ctx, group.ID, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove member: %v", err)
+	}
+	s.dropGroupConversation(ctx, email, group.ID)
+
+	return &v1.RemoveMemberResponse{Group: groupToProto(group)}, nil
+}
+
+// LeaveGroup removes the caller from a group. If the owner leaves, ownership
+// passes to the longest-standing remaining member.
+func (s *Server) LeaveGroup(ctx context.Context, req *v1.LeaveGroupRequest) (*v1.LeaveGroupResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	group, err := s.memberGroup(ctx, req.GetGroupId(), claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err = s.groups.RemoveMember(ctx, group.ID, claims.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to leave group: %v", err)
+	}
+	s.dropGroupConversation(ctx, claims.Email, group.ID)
+
+	if group.OwnerEmail == claims.Email && len(group.Members) > 0 {
+		if _, err := s.groups.SetOwner(ctx, group.ID, group.Members[0]); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to transfer ownership: %v", err)
+		}
+	}
+
+	return &v1.LeaveGroupResponse{}, nil
+}
+
+// inviteToGroup invites email, who doesn't take messages from inviter, to
+// group. The invite reaches them as a message request from inviter; email
+// joins once they accept it.
+func (s *Server) inviteToGroup(ctx context.Context, group *data.Group, inviter, email string) (*data.Group, error) {
+	group, created, err := s.groups.Invite(ctx, group.ID, email, inviter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to invite member: %v", err)
+	}
+	if !created {
+		return group, nil
+	}
+
+	saved, _, err := s.saveMessage(ctx, &data.Message{
+		FromEmail: inviter,
+		ToEmail:   email,
+		Content:   groupInviteNotice(inviter, group.Name),
+		SentAt:    time.Now(),
+		System:    true,
+		Pending:   true,
+	}, []string{inviter})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send invite: %v", err)
+	}
+	s.recordRequest(ctx, saved)
+	return group, nil
+}
+
+// groupInviteNotice is the text of the message request carrying an invite.
+func groupInviteNotice(inviter, groupName string) string {
+	return fmt.Sprintf("%s invited you to join %s", html.EscapeString(inviter), html.EscapeString(groupName))
+}
+
+// dropGroupConversation removes a former member's summary of a group so it no
+// longer takes a slot in their ListChats, and drops it from their WatchChats. Failures are logged; ListChats skips
+// groups the caller isn't a member of anyway.
+func (s *Server) dropGroupConversation(ctx context.Context, email string, groupID bson.ObjectID) {
+	if err := s.convs.DeleteGroupConversation(ctx, email, groupID); err != nil {
+		log.Printf("failed to drop conversation of %s in group %s: %v", email, groupID.Hex(), err)
+	}
+	if s.hub != nil {
+		s.hub.PublishChat(ChatEvent{Email: email, GroupID: groupID, Removed: true})
+	}
+}
+
+// loadGroup parses a hex group ID and fetches the group, mapping failures to gRPC statuses.
+func (s *Server) loadGroup(ctx context.Context, groupID string) (*data.Group, error) {
+	id, err := bson.ObjectIDFromHex(groupID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group_id")
+	}
+
+	group, err := s.groups.GetGroup(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrGroupNotFound) {
+			return nil, status.Errorf(codes.NotFound, "group not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load group: %v", err)
+	}
+	return group, nil
+}
+
+// memberGroup is loadGroup plus a check that email belongs to the group.
+func (s *Server) memberGroup(ctx context.Context, groupID, email string) (*data.Group, error) {
+	group, err := s.loadGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !group.HasMember(email) {
+		return nil, status.Errorf(codes.PermissionDenied, "not a member of this group")
+	}
+	return group, nil
+}
+
+// groupToProto converts a stored group into its API representation.
+func groupToProto(g *data.Group) *v1.Group {
+	return &v1.Group{
+		GroupId:       g.ID.Hex(),
+		Name:          g.Name,
+		OwnerEmail:    g.OwnerEmail,
+		MemberEmails:  g.Members,
+		InvitedEmails: g.InvitedEmails(),
+		CreatedAt:     timestamppb.New(g.CreatedAt),
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
 	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -29,17 +32,19 @@ func (s *Server) Register(ctx context.Context, req *v1.RegisterRequest) (*v1.Reg
 		return nil, status.Errorf(codes.Internal, "failed to create user")
 	}
 
-	// Generate JWT token for newly created user
-	token, expiresAt, err := s.auth.GenerateToken(user.ID, user.Email)
+	// Generate JWT and refresh tokens for newly created user
+	tokens, err := s.issueTokens(ctx, user, bson.ObjectID{})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+		return nil, err
 	}
 
 	// Build response using proto types
 	return &v1.RegisterResponse{
-		Token:     token,
-		UserId:    user.ID.Hex(),
-		ExpiresAt: timestamppb.New(expiresAt),
+		Token:                 tokens.access,
+		UserId:                user.ID.Hex(),
+		ExpiresAt:             timestamppb.New(tokens.accessExpiresAt),
+		RefreshToken:          tokens.refresh,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.refreshExpiresAt),
 	}, nil
 }
 
@@ -56,16 +61,18 @@ func (s *Server) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResp
 		return nil, status.Errorf(codes.PermissionDenied, "invalid credentials")
 	}
 
-	// Generate token
-	token, expiresAt, err := s.auth.GenerateToken(user.ID, user.Email)
+	// Generate tokens; each login starts a new refresh token family
+	tokens, err := s.issueTokens(ctx, user, bson.ObjectID{})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+		return nil, err
 	}
 
 	return &v1.LoginResponse{
-		Token:     token,
-		UserId:    user.ID.Hex(),
-		ExpiresAt: timestamppb.New(expiresAt),
+		Token:                 tokens.access,
+		UserId:                user.ID.Hex(),
+		ExpiresAt:             timestamppb.New(tokens.accessExpiresAt),
+		RefreshToken:          tokens.refresh,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.refreshExpiresAt),
 	}, nil
 }
 
@@ -82,18 +89,14 @@ func (s *Server) ListChats(req *v1.ListChatsRequest, stream v1.ChatService_ListC
 	if limit == 0 {
 		limit = 50
 	}
-	partners, err := s.msgs.GetRecentChats(stream.Context(), claims.Email, limit)
+	chats, err := s.chatList(stream.Context(), claims.Email, limit)
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to read recent chats: %v", err)
+		return err
 	}
 
-	for _, p := range partners {
+	for _, c := range chats {
 		// Stream each partner to client
-		if err := stream.Send(&v1.ListChatsResponse{
-			Email:         p.Email,
-			LastMessage:   p.LastMessage,
-			LastMessageAt: timestamppb.New(p.LastMessageTime),
-		}); err != nil {
+		if err := stream.Send(c); err != nil {
 			return status.Errorf(codes.Internal, "failed to send partner: %v", err)
 		}
 	}
@@ -107,20 +110,65 @@ func (s *Server) GetHistory(req *v1.GetHistoryRequest, stream v1.ChatService_Get
 		return status.Errorf(codes.Unauthenticated, "missing auth claims")
 	}
 
-	// Retrieve recent messages between the authenticated user and the requested partner
-	msgs, err := s.msgs.GetMessageHistory(stream.Context(), claims.Email, req.GetWithEmail(), 100)
+	pageSize, err := resolvePageSize(req.GetPageSize(), defaultHistoryPageSize, maxHistoryPageSize)
+	if err != nil {
+		return err
+	}
+
+	// Retrieve a page of messages between the authenticated user and the requested
+	// partner, or the group's messages when a group_id is given. One extra message
+	// is fetched to learn whether another page follows.
+	// Read watermarks of every participant decide each message's read flag
+	var msgs []*data.Message
+	var cursor *data.HistoryCursor
+	var participants []string
+	var watermarks readWatermarks
+	if req.GetGroupId() != "" {
+		group, gerr := s.memberGroup(stream.Context(), req.GetGroupId(), claims.Email)
+		if gerr != nil {
+			return gerr
+		}
+		cursor, gerr = s.historyCursor(stream.Context(), req, func(m *data.Message) bool {
+			return m.GroupID == group.ID
+		})
+		if gerr != nil {
+			return gerr
+		}
+		participants = group.Members
+		msgs, err = s.msgs.GetGroupHistory(stream.Context(), group.ID, claims.Email, cursor, int64(pageSize)+1)
+		if err == nil {
+			watermarks, err = s.convs.GetGroupReadAt(stream.Context(), group.ID)
+		}
+	} else {
+		partner := normalize.Email(req.GetWithEmail())
+		var cerr error
+		cursor, cerr = s.historyCursor(stream.Context(), req, func(m *data.Message) bool {
+			return m.GroupID.IsZero() &&
+				(m.FromEmail == claims.Email && m.ToEmail == partner || m.FromEmail == partner && m.ToEmail == claims.Email)
+		})
+		if cerr != nil {
+			return cerr
+		}
+		participants = []string{claims.Email, partner}
+		msgs, err = s.msgs.GetMessageHistory(stream.Context(), claims.Email, partner, cursor, int64(pageSize)+1)
+		if err == nil {
+			watermarks, err = s.oneToOneWatermarks(stream.Context(), claims.Email, partner)
+		}
+	}
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to get history: %v", err)
 	}
 
-	for _, m := range msgs {
-		if err := stream.Send(&v1.GetHistoryResponse{
-			MsgId:     m.ID.Hex(),
-			FromEmail: m.FromEmail,
-			ToEmail:   m.ToEmail,
-			Content:   m.Content,
-			SentAt:    timestamppb.New(m.SentAt),
-		}); err != nil {
+	msgs, nextCursor := historyPage(msgs, cursor, pageSize)
+	for i, m := range msgs {
+		resp := historyResponse(m)
+		if i == len(msgs)-1 {
+			resp.NextCursor = nextCursor
+		}
+		resp.Read = watermarks.readBy(m, participants)
+		resp.DeliveryState = deliveryState(m, participants, watermarks)
+		resp.Reactions = reactionCounts(m.Reactions, claims.Email)
+		if err := stream.Send(resp); err != nil {
 			return status.Errorf(codes.Internal, "failed to send message: %v", err)
 		}
 	}
@@ -145,8 +193,18 @@ func (s *Server) ChatStream(stream v1.ChatService_ChatStreamServer) error {
 		defer s.hub.Unregister(claims.Email, connID)
 	}
 
-	for {
-		// Receive message from client
+	// Replay anything this user hasn't acknowledged before switching to live delivery
+	redelivered, err := s.redeliver(stream, claims.Email)
+	if err != nil {
+		return err
+	}
+
+	// Typing events are ephemeral; throttle them per stream so a chatty client
+	// can't flood its partners
+	typing := newTypingLimiter()
+
+	for first := true; ; first = false {
+		// Receive the next event from client
 		req, err := stream.Recv()
 		if err == io.EOF {
 			return nil
@@ -155,43 +213,257 @@ func (s *Server) ChatStream(stream v1.ChatService_ChatStreamServer) error {
 			return status.Errorf(codes.Internal, "receive error: %v", err)
 		}
 
-		// Optionally verify recipient exists
-		exists, err := s.users.UserExists(stream.Context(), req.GetToEmail())
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to verify recipient: %v", err)
-		}
-		if !exists {
-			return status.Errorf(codes.NotFound, "recipient not found")
+		frames := []*v1.ChatStreamRequest{req}
+		if req.GetEvent() == nil {
+			frames = legacyFrames(req)
+			if len(frames) == 0 {
+				return status.Errorf(codes.InvalidArgument, "stream event is required")
+			}
 		}
 
-		// Save message in DB
-		saved, err := s.msgs.SaveMessage(stream.Context(), claims.Email, req.GetToEmail(), html.EscapeString(req.GetContent()), time.Now())
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to save message: %v", err)
+		for _, frame := range frames {
+			switch ev := frame.GetEvent().(type) {
+			case *v1.ChatStreamRequest_Resume:
+				if !first {
+					return status.Errorf(codes.InvalidArgument, "resume must be the first frame")
+				}
+				if err := s.resume(stream, claims.Email, ev.Resume.GetResumeAfter(), redelivered); err != nil {
+					return err
+				}
+			case *v1.ChatStreamRequest_Message:
+				if err := checkOutgoing(ev.Message); err != nil {
+					return err
+				}
+				if ev.Message.GetGroupId() != "" {
+					err = s.sendGroupMessage(stream, claims.Email, ev.Message)
+				} else {
+					err = s.sendDirectMessage(stream, claims.Email, ev.Message)
+				}
+				if err != nil {
+					return err
+				}
+			case *v1.ChatStreamRequest_TypingStarted:
+				if typing.Allow() {
+					s.relayTyping(stream.Context(), claims.Email, ev.TypingStarted, true)
+				}
+			case *v1.ChatStreamRequest_TypingStopped:
+				if typing.Allow() {
+					s.relayTyping(stream.Context(), claims.Email, ev.TypingStopped, false)
+				}
+			case *v1.ChatStreamRequest_Ack:
+				// Every ID costs a few queries, so bound them like the proto does
+				if len(ev.Ack.GetMsgIds()) > maxAckIDs {
+					return status.Errorf(codes.InvalidArgument, "at most %d msg_ids per ack", maxAckIDs)
+				}
+				s.ackDelivered(stream.Context(), claims.Email, ev.Ack.GetMsgIds())
+			default:
+				return status.Errorf(codes.InvalidArgument, "stream event is required")
+			}
 		}
+	}
+}
 
-		// Build response with the persisted message metadata
-		resp := &v1.ChatStreamResponse{
-			MsgId:     saved.ID.Hex(),
-			FromEmail: saved.FromEmail,
-			Content:   saved.Content,
-			SentAt:    timestamppb.New(saved.SentAt),
-		}
+// checkOutgoing validates a message frame, legacy frames included, before
+// anything is looked up or stored: it needs a recipient and content or
+// attachments, within the bounds declared in the proto.
+func checkOutgoing(msg *v1.OutgoingMessage) error {
+	if msg.GetGroupId() == "" && msg.GetToEmail() == "" {
+		return status.Errorf(codes.InvalidArgument, "to_email or group_id is required")
+	}
+	if msg.GetContent() == "" && len(msg.GetAttachmentIds()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "message needs content or attachments")
+	}
+	if len(msg.GetAttachmentIds()) > maxMessageAttachments {
+		return status.Errorf(codes.InvalidArgument, "at most %d attachments per message", maxMessageAttachments)
+	}
+	return checkContent(msg.GetContent())
+}
 
-		// Send acknowledgement back to sender
-		if err := stream.Send(resp); err != nil {
+// legacyFrames converts a flat frame from a client that predates the event
+// envelope into the equivalent event frames: an ack of ack_msg_ids and/or a
+// message carrying content. A frame with neither yields nothing.
+func legacyFrames(req *v1.ChatStreamRequest) []*v1.ChatStreamRequest {
+	var frames []*v1.ChatStreamRequest
+	if ids := req.GetAckMsgIds(); len(ids) > 0 {
+		frames = append(frames, &v1.ChatStreamRequest{
+			Event: &v1.ChatStreamRequest_Ack{Ack: &v1.AckEvent{MsgIds: ids}},
+		})
+	}
+	if req.GetContent() != "" {
+		frames = append(frames, &v1.ChatStreamRequest{
+			Event: &v1.ChatStreamRequest_Message{Message: &v1.OutgoingMessage{
+				ToEmail: req.GetToEmail(),
+				Content: req.GetContent(),
+				GroupId: req.GetGroupId(),
+			}},
+		})
+	}
+	return frames
+}
+
+// sendDirectMessage persists a 1-on-1 message, acknowledges it to the sender
+// and delivers it to the recipient's active streams.
+func (s *Server) sendDirectMessage(stream v1.ChatService_ChatStreamServer, fromEmail string, msg *v1.OutgoingMessage) error {
+	// Optionally verify recipient exists
+	exists, err := s.users.UserExists(stream.Context(), msg.GetToEmail())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to verify recipient: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.NotFound, "recipient not found")
+	}
+
+	// Blocked senders get a per-message error and the stream stays usable
+	toEmail := normalize.Email(msg.GetToEmail())
+	blocked, err := s.blocked(stream.Context(), fromEmail, toEmail)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+	if blocked {
+		if err := stream.Send(sendError(msg, v1.SendErrorReason_SEND_ERROR_REASON_BLOCKED, "messages between you and this user are blocked")); err != nil {
 			return status.Errorf(codes.Internal, "failed to send response to sender: %v", err)
 		}
+		return nil
+	}
+
+	// Replies must quote a message from this same 1-on-1 conversation
+	quote, root, err := s.replyTarget(stream.Context(), msg.GetReplyToMsgId(), func(m *data.Message) bool {
+		return m.GroupID.IsZero() &&
+			(m.FromEmail == fromEmail && m.ToEmail == toEmail || m.FromEmail == toEmail && m.ToEmail == fromEmail)
+	})
+	if err != nil {
+		return err
+	}
+	attachments, err := s.messageAttachments(stream.Context(), fromEmail, msg.GetAttachmentIds())
+	if err != nil {
+		return err
+	}
 
-		// Try to deliver the saved message to the recipient's active streams.
-		// This is best-effort — if the recipient isn't connected, the message is persisted
-		// and will be available via GetHistory when they reconnect.
-		if s.hub != nil {
-			if err := s.hub.SendToUser(req.GetToEmail(), resp); err != nil {
-				// Not connected or send failed — log and continue. This is deliberate: we don't
-				// want a single failing recipient stream to bring down the sender's stream.
-				log.Printf("delivery to %s failed (or user offline): %v", req.GetToEmail(), err)
+	// Messages from non-contacts wait in the recipient's message requests
+	pending, err := s.messageGate(stream.Context(), fromEmail, toEmail)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check contacts: %v", err)
+	}
+	participants := []string{fromEmail, toEmail}
+	if pending {
+		participants = []string{fromEmail}
+	}
+
+	// Messages sent while disappearing messages are on get an expiry
+	now := time.Now()
+	expiresAt, err := s.messageExpiry(stream.Context(), fromEmail, toEmail, now)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load conversation: %v", err)
+	}
+
+	// Save message in DB
+	saved, created, err := s.saveMessage(stream.Context(), &data.Message{
+		FromEmail:    fromEmail,
+		ToEmail:      toEmail,
+		Content:      html.EscapeString(msg.GetContent()),
+		SentAt:       now,
+		ExpiresAt:    expiresAt,
+		ReplyTo:      quote,
+		ThreadRootID: root,
+		Attachments:  attachments,
+		ClientMsgID:  msg.GetClientMsgId(),
+		Pending:      pending,
+	}, participants)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to save message: %v", err)
+	}
+
+	// Build response with the persisted message metadata
+	resp := streamResponse(saved)
+
+	// Send acknowledgement back to sender
+	if err := stream.Send(resp); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response to sender: %v", err)
+	}
+
+	// A retried send was delivered the first time round (or will be redelivered)
+	if !created {
+		return nil
+	}
+	if saved.Pending {
+		s.recordRequest(stream.Context(), saved)
+		return nil
+	}
+
+	// Try to deliver the saved message to the recipient's active streams.
+	// This is best-effort — if the recipient isn't connected, the message is persisted
+	// and will be redelivered when they reconnect.
+	if s.hub != nil {
+		if err := s.hub.SendToUser(saved.ToEmail, resp); err != nil {
+			// Not connected or send failed — log and continue. This is deliberate: we don't
+			// want a single failing recipient stream to bring down the sender's stream.
+			log.Printf("delivery to %s failed (or user offline): %v", saved.ToEmail, err)
+		}
+	}
+	return nil
+}
+
+// sendGroupMessage persists a group message, acknowledges it to the sender and
+// fans it out to every other online member of the group.
+func (s *Server) sendGroupMessage(stream v1.ChatService_ChatStreamServer, fromEmail string, msg *v1.OutgoingMessage) error {
+	group, err := s.memberGroup(stream.Context(), msg.GetGroupId(), fromEmail)
+	if err != nil {
+		return err
+	}
+
+	quote, root, err := s.replyTarget(stream.Context(), msg.GetReplyToMsgId(), func(m *data.Message) bool {
+		return m.GroupID == group.ID
+	})
+	if err != nil {
+		return err
+	}
+	attachments, err := s.messageAttachments(stream.Context(), fromEmail, msg.GetAttachmentIds())
+	if err != nil {
+		return err
+	}
+	audience, hidden, err := s.splitBlocked(stream.Context(), fromEmail, group.Members)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+
+	saved, created, err := s.saveMessage(stream.Context(), &data.Message{
+		FromEmail:    fromEmail,
+		GroupID:      group.ID,
+		Content:      html.EscapeString(msg.GetContent()),
+		SentAt:       time.Now(),
+		ReplyTo:      quote,
+		ThreadRootID: root,
+		Attachments:  attachments,
+		ClientMsgID:  msg.GetClientMsgId(),
+		HiddenFor:    hidden,
+	}, audience)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to save message: %v", err)
+	}
+
+	resp := streamResponse(saved)
+
+	if err := stream.Send(resp); err != nil {
+		return status.Errorf(codes.Internal, "failed to send response to sender: %v", err)
+	}
+
+	if !created {
+		return nil
+	}
+
+	// Fan out to the other members; like 1-on-1 delivery this is best-effort and
+	// offline members get the message redelivered when they reconnect. Members
+	// with a block with the sender never see the message.
+	if s.hub != nil {
+		recipients := make([]string, 0, len(audience))
+		for _, m := range audience {
+			if m != fromEmail {
+				recipients = append(recipients, m)
 			}
 		}
+		if err := s.hub.SendToUsers(recipients, resp); err != nil {
+			log.Printf("delivery to group %s incomplete: %v", group.ID.Hex(), err)
+		}
 	}
+	return nil
 }
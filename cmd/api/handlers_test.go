@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLegacyFrames(t *testing.T) {
+	// a legacy frame may carry acks and a message at once
+	frames := legacyFrames(&v1.ChatStreamRequest{
+		ToEmail:   "bob@example.com",
+		Content:   "hi",
+		AckMsgIds: []string{"a", "b"},
+	})
+	if len(frames) != 2 {
+		t.Fatalf("expected an ack and a message frame, got %d", len(frames))
+	}
+	if ids := frames[0].GetAck().GetMsgIds(); len(ids) != 2 || ids[0] != "a" {
+		t.Fatalf("unexpected ack frame: %v", frames[0])
+	}
+	if m := frames[1].GetMessage(); m.GetToEmail() != "bob@example.com" || m.GetContent() != "hi" {
+		t.Fatalf("unexpected message frame: %v", frames[1])
+	}
+
+	if frames := legacyFrames(&v1.ChatStreamRequest{GroupId: "g", Content: "team"}); len(frames) != 1 || frames[0].GetMessage().GetGroupId() != "g" {
+		t.Fatalf("expected a group message frame, got %v", frames)
+	}
+	if frames := legacyFrames(&v1.ChatStreamRequest{}); len(frames) != 0 {
+		t.Fatalf("expected no frames for an empty legacy frame, got %v", frames)
+	}
+}
+
+func TestStreamResponse_LegacyFields(t *testing.T) {
+	m := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", Content: "hi", SentAt: time.Now()}
+	resp := streamResponse(m)
+	if resp.GetMsgId() != m.ID.Hex() || resp.GetFromEmail() != "alice@example.com" || resp.GetContent() != "hi" || resp.GetSentAt() == nil {
+		t.Fatalf("legacy fields not mirrored: %v", resp)
+	}
+	if resp.GetMessage().GetMsgId() != m.ID.Hex() {
+		t.Fatalf("message event missing: %v", resp)
+	}
+}
+
+func TestCheckOutgoing(t *testing.T) {
+	long := strings.Repeat("é", maxContentLen+1)
+	bad := []*v1.OutgoingMessage{
+		{Content: "hi"},
+		{ToEmail: "bob@example.com"},
+		{ToEmail: "bob@example.com", Content: long},
+		{GroupId: "g", AttachmentIds: make([]string, maxMessageAttachments+1)},
+		// legacy frames go through the same checks
+		legacyFrames(&v1.ChatStreamRequest{ToEmail: "bob@example.com", Content: long})[0].GetMessage(),
+	}
+	for _, m := range bad {
+		if err := checkOutgoing(m); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("checkOutgoing(%d chars, %d attachments): got %v, want InvalidArgument", len([]rune(m.GetContent())), len(m.GetAttachmentIds()), err)
+		}
+	}
+	if err := checkOutgoing(&v1.OutgoingMessage{ToEmail: "bob@example.com", Content: strings.Repeat("é", maxContentLen)}); err != nil {
+		t.Fatalf("checkOutgoing rejected content at the limit: %v", err)
+	}
+}
+
+func TestContentLimit_EditAndSchedule(t *testing.T) {
+	s := &Server{}
+	long := strings.Repeat("a", maxContentLen+1)
+	if _, err := s.EditMessage(claimsContext("alice@example.com"), &v1.EditMessageRequest{MsgId: bson.NewObjectID().Hex(), Content: long}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("EditMessage with long content: got %v, want InvalidArgument", err)
+	}
+	if _, err := s.EditMessage(claimsContext("alice@example.com"), &v1.EditMessageRequest{MsgId: bson.NewObjectID().Hex()}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("EditMessage without content: got %v, want InvalidArgument", err)
+	}
+	if _, err := s.ScheduleMessage(claimsContext("alice@example.com"), &v1.ScheduleMessageRequest{ToEmail: "bob@example.com", Content: long}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ScheduleMessage with long content: got %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultHistoryPageSize is used when GetHistory is called without a page_size
+	defaultHistoryPageSize = 100
+	// maxHistoryPageSize matches the page_size bound declared in the proto
+	maxHistoryPageSize = 200
+)
+
+// resolvePageSize resolves a requested page size: 0 selects def and anything outside
+// 0..max is rejected. The proto bounds aren't enforced by any interceptor, and
+// a negative size would both disable the query limit and break page trimming.
+func resolvePageSize(requested int32, def, max int) (int, error) {
+	if requested < 0 || int(requested) > max {
+		return 0, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", max)
+	}
+	if requested == 0 {
+		return def, nil
+	}
+	return int(requested), nil
+}
+
+// historyCursor resolves before_msg_id/after_msg_id into a store cursor.
+// inConversation must report whether the cursor message belongs to the
+// conversation being paged. No cursor means the latest page.
+func (s *Server) historyCursor(ctx context.Context, req *v1.GetHistoryRequest, inConversation func(*data.Message) bool) (*data.HistoryCursor, error) {
+	before, after := req.GetBeforeMsgId(), req.GetAfterMsgId()
+	if before != "" && after != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "before_msg_id and after_msg_id are mutually exclusive")
+	}
+	msgID := before
+	if after != "" {
+		msgID = after
+	}
+	if msgID == "" {
+		return nil, nil
+	}
+
+	msg, err := s.loadMessage(ctx, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if !inConversation(msg) {
+		return nil, status.Errorf(codes.InvalidArgument, "cursor message is not in this conversation")
+	}
+	return data.CursorAt(msg, after != ""), nil
+}
+
+// historyPage trims a page fetched with pageSize+1 messages down to pageSize
+// and returns the cursor for the following page, or "" when there is none.
+// Pages grow away from the cursor: older for before/latest, newer for after.
+func historyPage(msgs []*data.Message, cursor *data.HistoryCursor, pageSize int) ([]*data.Message, string) {
+	if len(msgs) <= pageSize {
+		return msgs, ""
+	}
+	if cursor != nil && cursor.After {
+		msgs = msgs[:pageSize]
+		return msgs, msgs[len(msgs)-1].ID.Hex()
+	}
+	msgs = msgs[len(msgs)-pageSize:]
+	return msgs, msgs[0].ID.Hex()
+}
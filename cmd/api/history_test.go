@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHistoryPage(t *testing.T) {
+	page := make([]*data.Message, 4)
+	for i := range page {
+		page[i] = &data.Message{ID: bson.NewObjectID()}
+	}
+
+	// a short page has no next cursor
+	if got, next := historyPage(page[:2], nil, 3); len(got) != 2 || next != "" {
+		t.Fatalf("expected full short page without cursor, got %d %q", len(got), next)
+	}
+
+	// latest/before pages drop the oldest extra message and continue from the oldest kept
+	got, next := historyPage(page, nil, 3)
+	if len(got) != 3 || got[0] != page[1] || next != page[1].ID.Hex() {
+		t.Fatalf("unexpected before page: %d %q", len(got), next)
+	}
+
+	// after pages drop the newest extra message and continue from the newest kept
+	got, next = historyPage(page, &data.HistoryCursor{After: true}, 3)
+	if len(got) != 3 || got[2] != page[2] || next != page[2].ID.Hex() {
+		t.Fatalf("unexpected after page: %d %q", len(got), next)
+	}
+}
+
+// historyStream is a GetHistory stream that only carries a context.
+type historyStream struct {
+	v1.ChatService_GetHistoryServer
+	ctx context.Context
+}
+
+func (h historyStream) Context() context.Context { return h.ctx }
+
+func TestGetHistory_PageSizeBounds(t *testing.T) {
+	s := &Server{}
+	ctx := context.WithValue(context.Background(), authContextKey{}, &auth.Claims{Email: "alice@example.com"})
+
+	for _, size := range []int32{-1, maxHistoryPageSize + 1} {
+		err := s.GetHistory(&v1.GetHistoryRequest{WithEmail: "bob@example.com", PageSize: size}, historyStream{ctx: ctx})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("page_size %d: got %v, want InvalidArgument", size, err)
+		}
+	}
+
+	if got, err := resolvePageSize(0, defaultHistoryPageSize, maxHistoryPageSize); err != nil || got != defaultHistoryPageSize {
+		t.Errorf("resolvePageSize(0) = %d, %v; want the default", got, err)
+	}
+	if got, err := resolvePageSize(maxHistoryPageSize, defaultHistoryPageSize, maxHistoryPageSize); err != nil || got != maxHistoryPageSize {
+		t.Errorf("resolvePageSize(max) = %d, %v; want max", got, err)
+	}
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 // StreamSender defines the minimal interface the hub needs from a stream: the ability
@@ -13,18 +16,214 @@ type StreamSender interface {
 	Send(*v1.ChatStreamResponse) error
 }
 
+// PresenceEvent reports a user coming online (first stream registered) or
+// going offline (last stream unregistered).
+type PresenceEvent struct {
+	Email  string
+	Online bool
+	At     time.Time
+}
+
+// ChatEvent reports that Email's summary of a conversation changed: a new
+// last message, an unread count change, or (Removed) leaving a group.
+// PartnerEmail identifies 1-on-1 conversations, GroupID group ones.
+type ChatEvent struct {
+	Email        string
+	PartnerEmail string
+	GroupID      bson.ObjectID
+	Removed      bool
+}
+
 // ConnectionHub manages active chat streams for connected users.
 // It maps user email addresses to one or more active stream connections so the
 // server can push messages to all currently-connected endpoints for a user.
 type ConnectionHub struct {
-	mu      sync.RWMutex
-	streams map[string]map[int64]StreamSender
-	nextID  int64
+	mu       sync.RWMutex
+	streams  map[string]map[int64]StreamSender
+	watchers map[int64]*presenceWatcher
+	// chatWatchers holds chat list subscriptions keyed by owner email
+	chatWatchers map[string]map[int64]chan ChatEvent
+	// offlineSince remembers when offline users' last stream closed, so
+	// presence is right before recordLastSeen has persisted it
+	offlineSince map[string]time.Time
+	nextID       int64
 }
 
 // NewConnectionHub creates a new hub instance.
 func NewConnectionHub() *ConnectionHub {
-	return &ConnectionHub{streams: make(map[string]map[int64]StreamSender)}
+	return &ConnectionHub{
+		streams:      make(map[string]map[int64]StreamSender),
+		watchers:     make(map[int64]*presenceWatcher),
+		chatWatchers: make(map[string]map[int64]chan ChatEvent),
+		offlineSince: make(map[string]time.Time),
+	}
+}
+
+// SubscribePresence returns a channel receiving every presence change and a
+// func that ends the subscription and closes the channel. A slow watcher
+// can't stall the hub: once its buffer is full, further events are coalesced
+// to the latest state per user and fed to it as it catches up, so it may skip
+// intermediate transitions but always ends up with each user's current state.
+func (h *ConnectionHub) SubscribePresence(buffer int) (<-chan PresenceEvent, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := h.nextID
+	w := &presenceWatcher{
+		ch:     make(chan PresenceEvent, buffer),
+		done:   make(chan struct{}),
+		latest: make(map[string]PresenceEvent),
+	}
+	h.watchers[id] = w
+
+	cancel := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.watchers[id]; ok {
+			delete(h.watchers, id)
+			w.close()
+		}
+	}
+	return w.ch, cancel
+}
+
+// publishPresence fans ev out to subscribers. Callers must hold h.mu for
+// writing so events are published in the order the transitions happened.
+func (h *ConnectionHub) publishPresence(ev PresenceEvent) {
+	for _, w := range h.watchers {
+		w.publish(ev)
+	}
+}
+
+// presenceWatcher is one SubscribePresence subscription. Events go straight
+// to ch while it has room; after that they queue up, one per user, and a pump
+// goroutine moves them to ch in the order the users first queued.
+type presenceWatcher struct {
+	ch   chan PresenceEvent
+	done chan struct{}
+
+	mu     sync.Mutex
+	latest map[string]PresenceEvent
+	order  []string
+	// pumping is set while a pump goroutine owns the queue; it then also owns
+	// closing ch
+	pumping bool
+	closed  bool
+}
+
+// publish delivers ev, queueing it if ch is full or older events still wait.
+func (w *presenceWatcher) publish(ev PresenceEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	if len(w.order) == 0 && !w.pumping {
+		select {
+		case w.ch <- ev:
+			return
+		default:
+		}
+	}
+
+	if _, queued := w.latest[ev.Email]; !queued {
+		w.order = append(w.order, ev.Email)
+	}
+	w.latest[ev.Email] = ev
+	if !w.pumping {
+		w.pumping = true
+		go w.pump()
+	}
+}
+
+// pump feeds queued events to ch until the queue is empty or the
+// subscription ends.
+func (w *presenceWatcher) pump() {
+	for {
+		w.mu.Lock()
+		if len(w.order) == 0 || w.closed {
+			w.pumping = false
+			if w.closed {
+				close(w.ch)
+			}
+			w.mu.Unlock()
+			return
+		}
+		email := w.order[0]
+		w.order = w.order[1:]
+		ev := w.latest[email]
+		delete(w.latest, email)
+		w.mu.Unlock()
+
+		select {
+		case w.ch <- ev:
+		case <-w.done:
+		}
+	}
+}
+
+// close ends the subscription. ch is closed here, or by a running pump once
+// it notices.
+func (w *presenceWatcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	close(w.done)
+	if !w.pumping {
+		close(w.ch)
+	}
+}
+
+// SubscribeChats returns a channel receiving changes to email's chat list and
+// a func that ends the subscription. Unlike presence, chat events can't be
+// coalesced without leaving the list stale, so a subscriber whose buffer fills up
+// is evicted instead: its channel is closed and it must resubscribe.
+func (h *ConnectionHub) SubscribeChats(email string, buffer int) (<-chan ChatEvent, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := h.nextID
+	ch := make(chan ChatEvent, buffer)
+	if h.chatWatchers[email] == nil {
+		h.chatWatchers[email] = make(map[int64]chan ChatEvent)
+	}
+	h.chatWatchers[email][id] = ch
+
+	cancel := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.removeChatWatcher(email, id)
+	}
+	return ch, cancel
+}
+
+// PublishChat delivers ev to every chat list subscription of ev.Email.
+func (h *ConnectionHub) PublishChat(ev ChatEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for id, ch := range h.chatWatchers[ev.Email] {
+		select {
+		case ch <- ev:
+		default:
+			h.removeChatWatcher(ev.Email, id)
+		}
+	}
+}
+
+// removeChatWatcher closes and forgets a chat subscription. Callers must hold
+// h.mu for writing.
+func (h *ConnectionHub) removeChatWatcher(email string, id int64) {
+	subs := h.chatWatchers[email]
+	if ch, ok := subs[id]; ok {
+		delete(subs, id)
+		close(ch)
+		if len(subs) == 0 {
+			delete(h.chatWatchers, email)
+		}
+	}
 }
 
 // Register registers a stream for the given email and returns a connection id which
@@ -35,6 +234,8 @@ func (h *ConnectionHub) Register(email string, s StreamSender) int64 {
 
 	if _, ok := h.streams[email]; !ok {
 		h.streams[email] = make(map[int64]StreamSender)
+		delete(h.offlineSince, email)
+		h.publishPresence(PresenceEvent{Email: email, Online: true, At: time.Now()})
 	}
 
 	h.nextID++
@@ -52,6 +253,9 @@ func (h *ConnectionHub) Unregister(email string, id int64) {
 		delete(conns, id)
 		if len(conns) == 0 {
 			delete(h.streams, email)
+			now := time.Now()
+			h.offlineSince[email] = now
+			h.publishPresence(PresenceEvent{Email: email, Online: false, At: now})
 		}
 	}
 }
@@ -93,3 +297,34 @@ func (h *ConnectionHub) SendToUser(email string, resp *v1.ChatStreamResponse) er
 
 	return firstErr
 }
+
+// SendToUsers fans resp out to every connected stream of each given email.
+// Offline users are skipped; send failures are joined into the returned error.
+func (h *ConnectionHub) SendToUsers(emails []string, resp *v1.ChatStreamResponse) error {
+	var errs []error
+	for _, email := range emails {
+		if !h.IsOnline(email) {
+			continue
+		}
+		if err := h.SendToUser(email, resp); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// IsOnline reports whether the given email has at least one active stream.
+func (h *ConnectionHub) IsOnline(email string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.streams[email]) > 0
+}
+
+// OfflineSince returns when email's last stream closed, if that happened
+// since the hub started and they haven't come back online.
+func (h *ConnectionHub) OfflineSince(email string) (time.Time, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	at, ok := h.offlineSince[email]
+	return at, ok
+}
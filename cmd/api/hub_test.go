@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"testing"
+	"time"
 
 	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
 )
@@ -20,6 +21,15 @@ func (f *fakeSender) Send(r *v1.ChatStreamResponse) error {
 	return nil
 }
 
+// messageEvent builds a ChatStreamResponse carrying a chat message.
+func messageEvent(id, from, content string) *v1.ChatStreamResponse {
+	return &v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_Message{
+			Message: &v1.ChatMessage{MsgId: id, FromEmail: from, Content: content},
+		},
+	}
+}
+
 func TestConnectionHub_RegisterAndSend(t *testing.T) {
 	hub := NewConnectionHub()
 
@@ -29,25 +39,25 @@ func TestConnectionHub_RegisterAndSend(t *testing.T) {
 	idA := hub.Register("alice@example.com", senderA)
 	_ = hub.Register("alice@example.com", senderB) // second connection
 
-	resp := &v1.ChatStreamResponse{MsgId: "m1", FromEmail: "bob@example.com", Content: "hello"}
+	resp := messageEvent("m1", "bob@example.com", "hello")
 
 	if err := hub.SendToUser("alice@example.com", resp); err != nil {
 		t.Fatalf("expected send success, got error: %v", err)
 	}
 
-	if senderA.last == nil || senderA.last.MsgId != "m1" {
+	if senderA.last == nil || senderA.last.GetMessage().GetMsgId() != "m1" {
 		t.Fatalf("sender A did not receive message")
 	}
 
 	// Unregister senderA and ensure it no longer receives messages
 	hub.Unregister("alice@example.com", idA)
 
-	resp2 := &v1.ChatStreamResponse{MsgId: "m2", FromEmail: "charlie@example.com", Content: "yo"}
+	resp2 := messageEvent("m2", "charlie@example.com", "yo")
 	if err := hub.SendToUser("alice@example.com", resp2); err != nil {
 		t.Fatalf("expected send success after unregistering one connection: %v", err)
 	}
 
-	if senderA.last.MsgId == "m2" {
+	if senderA.last.GetMessage().GetMsgId() == "m2" {
 		t.Fatalf("sender A should not have received second message after unregister")
 	}
 }
@@ -69,18 +79,135 @@ func TestConnectionHub_SendPartialFailure(t *testing.T) {
 	_ = hub.Register("d@example.com", ok)
 	_ = hub.Register("d@example.com", bad)
 
-	if err := hub.SendToUser("d@example.com", &v1.ChatStreamResponse{MsgId: "x"}); err == nil {
+	if err := hub.SendToUser("d@example.com", messageEvent("x", "", "")); err == nil {
 		t.Fatalf("expected error due to partial sender failure")
 	}
 
 	// After a partial failure, the failing connection should have been
 	// automatically unregistered. A subsequent send should succeed and only
 	// reach the healthy sender.
-	if err := hub.SendToUser("d@example.com", &v1.ChatStreamResponse{MsgId: "y"}); err != nil {
+	if err := hub.SendToUser("d@example.com", messageEvent("y", "", "")); err != nil {
 		t.Fatalf("expected send to succeed after cleanup of failed connections: %v", err)
 	}
 
-	if ok.last == nil || ok.last.MsgId != "y" {
+	if ok.last == nil || ok.last.GetMessage().GetMsgId() != "y" {
 		t.Fatalf("healthy sender did not receive message after cleanup")
 	}
 }
+
+func TestConnectionHub_SendToUsersSkipsOffline(t *testing.T) {
+	hub := NewConnectionHub()
+
+	a := &fakeSender{}
+	b := &fakeSender{}
+	_ = hub.Register("a@example.com", a)
+	_ = hub.Register("b@example.com", b)
+
+	resp := messageEvent("g1", "", "")
+	if err := hub.SendToUsers([]string{"a@example.com", "b@example.com", "offline@example.com"}, resp); err != nil {
+		t.Fatalf("offline members should be skipped, got error: %v", err)
+	}
+
+	if a.last == nil || a.last.GetMessage().GetMsgId() != "g1" || b.last == nil || b.last.GetMessage().GetMsgId() != "g1" {
+		t.Fatalf("expected both online members to receive the group message")
+	}
+}
+
+func TestConnectionHub_PresenceEvents(t *testing.T) {
+	hub := NewConnectionHub()
+	events, cancel := hub.SubscribePresence(8)
+	defer cancel()
+
+	id1 := hub.Register("alice@example.com", &fakeSender{})
+	id2 := hub.Register("alice@example.com", &fakeSender{}) // second device: no new event
+	hub.Unregister("alice@example.com", id1)                // still online: no event
+	hub.Unregister("alice@example.com", id2)
+
+	first := <-events
+	if first.Email != "alice@example.com" || !first.Online {
+		t.Fatalf("expected online event, got %+v", first)
+	}
+	second := <-events
+	if second.Online || second.At.IsZero() {
+		t.Fatalf("expected offline event, got %+v", second)
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected extra event %+v", ev)
+	default:
+	}
+
+	// cancelled subscriptions stop receiving and close the channel
+	cancel()
+	if _, ok := <-events; ok {
+		t.Fatalf("expected closed channel after cancel")
+	}
+}
+
+func TestConnectionHub_PresenceOverflow(t *testing.T) {
+	hub := NewConnectionHub()
+	events, cancel := hub.SubscribePresence(1)
+	defer cancel()
+
+	// alice's online event fills the buffer; everything after is coalesced
+	alice := hub.Register("alice@example.com", &fakeSender{})
+	bob := hub.Register("bob@example.com", &fakeSender{})
+	hub.Unregister("alice@example.com", alice)
+	hub.Unregister("bob@example.com", bob)
+	alice = hub.Register("alice@example.com", &fakeSender{})
+	hub.Unregister("alice@example.com", alice)
+
+	final := map[string]bool{}
+	var got []PresenceEvent
+	for len(got) < 3 {
+		select {
+		case ev := <-events:
+			got = append(got, ev)
+			final[ev.Email] = ev.Online
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %d events: %+v", len(got), got)
+		}
+	}
+	if !got[0].Online || got[0].Email != "alice@example.com" {
+		t.Fatalf("expected the buffered online event first, got %+v", got[0])
+	}
+	if final["alice@example.com"] || final["bob@example.com"] {
+		t.Fatalf("expected both users to end offline, got %+v", got)
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected extra event %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// the subscription still works once drained
+	hub.Register("carol@example.com", &fakeSender{})
+	if ev := <-events; ev.Email != "carol@example.com" || !ev.Online {
+		t.Fatalf("expected carol online, got %+v", ev)
+	}
+}
+
+func TestConnectionHub_ChatEvents(t *testing.T) {
+	hub := NewConnectionHub()
+	events, cancel := hub.SubscribeChats("alice@example.com", 1)
+	defer cancel()
+
+	hub.PublishChat(ChatEvent{Email: "bob@example.com", PartnerEmail: "carol@example.com"}) // someone else's list
+	hub.PublishChat(ChatEvent{Email: "alice@example.com", PartnerEmail: "bob@example.com"})
+
+	ev := <-events
+	if ev.Email != "alice@example.com" || ev.PartnerEmail != "bob@example.com" {
+		t.Fatalf("unexpected chat event %+v", ev)
+	}
+
+	// a full buffer evicts the subscriber instead of dropping the change
+	hub.PublishChat(ChatEvent{Email: "alice@example.com", PartnerEmail: "bob@example.com"})
+	hub.PublishChat(ChatEvent{Email: "alice@example.com", PartnerEmail: "carol@example.com"})
+	<-events
+	if _, ok := <-events; ok {
+		t.Fatalf("expected closed channel after overflow")
+	}
+
+	// cancelling an evicted subscription is a no-op
+	cancel()
+}
@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/blob"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
 	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -31,11 +34,29 @@ func TestRegisterAndLogin(t *testing.T) {
 	defer func() {
 		_ = dbClient.UsersCollection().Drop(context.Background())
 		_ = dbClient.MessagesCollection().Drop(context.Background())
+		_ = dbClient.GroupsCollection().Drop(context.Background())
+		_ = dbClient.ConversationsCollection().Drop(context.Background())
+		_ = dbClient.AttachmentsCollection().Drop(context.Background())
+		_ = dbClient.ScheduledMessagesCollection().Drop(context.Background())
+		_ = dbClient.BlocksCollection().Drop(context.Background())
+		_ = dbClient.ContactsCollection().Drop(context.Background())
+		_ = dbClient.RefreshTokensCollection().Drop(context.Background())
 		_ = dbClient.Close(context.Background())
 	}()
 
 	usersStore := data.NewUsersStore(dbClient.UsersCollection())
 	msgsStore := data.NewMessagesStore(dbClient.MessagesCollection())
+	groupsStore := data.NewGroupsStore(dbClient.GroupsCollection())
+	convsStore := data.NewConversationsStore(dbClient.ConversationsCollection())
+	attsStore := data.NewAttachmentsStore(dbClient.AttachmentsCollection())
+	scheduledStore := data.NewScheduledStore(dbClient.ScheduledMessagesCollection())
+	blocksStore := data.NewBlocksStore(dbClient.BlocksCollection())
+	contactsStore := data.NewContactsStore(dbClient.ContactsCollection())
+	tokensStore := data.NewRefreshTokensStore(dbClient.RefreshTokensCollection())
+	blobStore, err := blob.NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("blob.NewFSStore failed: %v", err)
+	}
 	jwtMgr := auth.NewJWTManager("test-secret", time.Hour)
 
 	// set up bufconn server
@@ -46,7 +67,7 @@ func TestRegisterAndLogin(t *testing.T) {
 	)
 
 	hub := NewConnectionHub()
-	srv := newServer(usersStore, msgsStore, jwtMgr, hub)
+	srv := newServer(usersStore, msgsStore, groupsStore, convsStore, attsStore, scheduledStore, blocksStore, contactsStore, tokensStore, blobStore, jwtMgr, hub)
 	v1.RegisterChatServiceServer(s, srv)
 
 	go func() {
@@ -81,8 +102,30 @@ func TestRegisterAndLogin(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Login RPC failed: %v", err)
 	}
-	if loginResp.GetToken() == "" {
-		t.Fatalf("Login response missing token")
+	if loginResp.GetToken() == "" || loginResp.GetRefreshToken() == "" {
+		t.Fatalf("Login response missing token or refresh_token")
+	}
+
+	// Refresh rotates the refresh token
+	refreshResp, err := client.RefreshToken(ctx, &v1.RefreshTokenRequest{RefreshToken: loginResp.GetRefreshToken()})
+	if err != nil {
+		t.Fatalf("RefreshToken RPC failed: %v", err)
+	}
+	if refreshResp.GetToken() == "" || refreshResp.GetRefreshToken() == loginResp.GetRefreshToken() {
+		t.Fatalf("RefreshToken should return a new access token and refresh token")
+	}
+
+	// Reusing the rotated token fails and revokes its successor too
+	if _, err := client.RefreshToken(ctx, &v1.RefreshTokenRequest{RefreshToken: loginResp.GetRefreshToken()}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for a reused refresh token, got %v", err)
+	}
+	if _, err := client.RefreshToken(ctx, &v1.RefreshTokenRequest{RefreshToken: refreshResp.GetRefreshToken()}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected the token family to be revoked, got %v", err)
+	}
+
+	// Register's refresh token belongs to a different login and still works
+	if _, err := client.RefreshToken(ctx, &v1.RefreshTokenRequest{RefreshToken: regResp.GetRefreshToken()}); err != nil {
+		t.Fatalf("RefreshToken with the Register token failed: %v", err)
 	}
 
 	// shutdown server
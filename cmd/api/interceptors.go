@@ -25,12 +25,14 @@ func getClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
 }
 
 // authUnaryInterceptor returns a UnaryServerInterceptor that enforces JWT authentication
-// for all methods except the allowed unauthenticated list (Register, Login).
+// for all methods except the allowed unauthenticated list (Register, Login,
+// RefreshToken).
 func authUnaryInterceptor(j *auth.JWTManager) grpc.UnaryServerInterceptor {
 	// methods that don't require authentication
 	allowed := map[string]bool{
-		"/chat.v1.ChatService/Register": true,
-		"/chat.v1.ChatService/Login":    true,
+		"/chat.v1.ChatService/Register":     true,
+		"/chat.v1.ChatService/Login":        true,
+		"/chat.v1.ChatService/RefreshToken": true,
 	}
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/blob"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/middleware"
@@ -58,10 +59,69 @@ func main() {
 	// Create stores
 	usersStore := data.NewUsersStore(dbClient.UsersCollection())
 	msgsStore := data.NewMessagesStore(dbClient.MessagesCollection())
+	groupsStore := data.NewGroupsStore(dbClient.GroupsCollection())
+	convsStore := data.NewConversationsStore(dbClient.ConversationsCollection())
+	attsStore := data.NewAttachmentsStore(dbClient.AttachmentsCollection())
+	scheduledStore := data.NewScheduledStore(dbClient.ScheduledMessagesCollection())
+	blocksStore := data.NewBlocksStore(dbClient.BlocksCollection())
+	contactsStore := data.NewContactsStore(dbClient.ContactsCollection())
+	tokensStore := data.NewRefreshTokensStore(dbClient.RefreshTokensCollection())
 
-	// Initialize auth manager (token valid for 24 hours). If JWT_KEYS supplied
-	// we parse keys so token rotation is possible; otherwise fall back to single
-	// JWT_SECRET value for backward compatibility.
+	// Chats from before conversation summaries existed get theirs once
+	err = dbClient.RunMigration(ctx, "backfill_conversations", func(ctx context.Context) error {
+		n, err := backfillConversations(ctx, msgsStore, convsStore)
+		if err == nil {
+			log.Printf("backfilled %d conversations", n)
+		}
+		return err
+	})
+	if err != nil {
+		log.Fatalf("failed to backfill conversations: %v", err)
+	}
+	// Messages stored before delivery acks were already delivered the old way
+	err = dbClient.RunMigration(ctx, "backfill_delivered_to", func(ctx context.Context) error {
+		n, err := msgsStore.BackfillDelivered(ctx)
+		if err == nil {
+			log.Printf("marked %d messages as delivered", n)
+		}
+		return err
+	})
+	if err != nil {
+		log.Fatalf("failed to backfill deliveries: %v", err)
+	}
+
+	// Attachment contents go to a pluggable blob store: BLOB_BACKEND=fs (default)
+	// writes files under BLOB_DIR, BLOB_BACKEND=gridfs keeps them in MongoDB
+	var blobStore blob.Store
+	switch backend := os.Getenv("BLOB_BACKEND"); backend {
+	case "", "fs":
+		blobDir := os.Getenv("BLOB_DIR")
+		if blobDir == "" {
+			blobDir = "./data/attachments"
+		}
+		fsStore, err := blob.NewFSStore(blobDir)
+		if err != nil {
+			log.Fatalf("failed to set up blob store: %v", err)
+		}
+		blobStore = fsStore
+	case "gridfs":
+		blobStore = blob.NewGridFSStore(dbClient.AttachmentsBucket())
+	default:
+		log.Fatalf("unknown BLOB_BACKEND %q (want fs or gridfs)", backend)
+	}
+
+	// Access tokens are short-lived and renewed with RefreshToken;
+	// ACCESS_TOKEN_TTL (a Go duration such as 15m) overrides the default
+	accessTTL := 15 * time.Minute
+	if v := os.Getenv("ACCESS_TOKEN_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			accessTTL = d
+		}
+	}
+
+	// Initialize auth manager. If JWT_KEYS supplied we parse keys so token
+	// rotation is possible; otherwise fall back to single JWT_SECRET value for
+	// backward compatibility.
 	var jwtMgr *auth.JWTManager
 	if jwtKeysEnv != "" {
 		// parse kid:key pairs
@@ -77,9 +137,9 @@ func main() {
 			}
 			keyMap[parts[0]] = parts[1]
 		}
-		jwtMgr = auth.NewJWTManagerFromKeys(keyMap, jwtActiveKid, 24*time.Hour)
+		jwtMgr = auth.NewJWTManagerFromKeys(keyMap, jwtActiveKid, accessTTL)
 	} else {
-		jwtMgr = auth.NewJWTManager(jwtSecret, 24*time.Hour)
+		jwtMgr = auth.NewJWTManager(jwtSecret, accessTTL)
 	}
 
 	// Build a rate limiter for Register and Login endpoints, then chain interceptors.
@@ -127,9 +187,48 @@ func main() {
 
 	// Create connection hub, service instance and register
 	hub := NewConnectionHub()
-	srv := newServer(usersStore, msgsStore, jwtMgr, hub)
+	srv := newServer(usersStore, msgsStore, groupsStore, convsStore, attsStore, scheduledStore, blocksStore, contactsStore, tokensStore, blobStore, jwtMgr, hub)
+	if v := os.Getenv("ATTACHMENT_MAX_BYTES"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			srv.maxAttachmentBytes = n
+		}
+	}
+	if v := os.Getenv("PIN_LIMIT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			srv.maxPins = n
+		}
+	}
+	if v := os.Getenv("REFRESH_TOKEN_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			srv.refreshTTL = d
+		}
+	}
+	// SearchUsers is limited per caller; SEARCH_RATE_LIMIT_RPM controls its
+	// requests per minute
+	searchRPM := 30
+	if v := os.Getenv("SEARCH_RATE_LIMIT_RPM"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			searchRPM = n
+		}
+	}
+	srv.searchLimiter = middleware.NewLimiterStore(searchRPM, 5, 1*time.Minute)
+	defer srv.searchLimiter.Stop()
 	v1.RegisterChatServiceServer(grpcServer, srv)
 
+	// Persist last_seen from the hub's presence events
+	presenceCtx, stopPresence := context.WithCancel(context.Background())
+	defer stopPresence()
+	presenceDone := make(chan struct{})
+	go func() {
+		srv.recordLastSeen(presenceCtx)
+		close(presenceDone)
+	}()
+
+	// Send scheduled messages as they fall due
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	defer stopDispatch()
+	go srv.dispatchScheduled(dispatchCtx)
+
 	// Listen and serve
 	listenAddr := fmt.Sprintf(":%s", port)
 	lis, err := net.Listen("tcp", listenAddr)
@@ -151,4 +250,8 @@ func main() {
 
 	log.Printf("shutting down gRPC server")
 	grpcServer.GracefulStop()
+
+	// The streams just closed went offline; let their last_seen be stored
+	stopPresence()
+	<-presenceDone
 }
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"html"
+	"log"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxContentLen matches the content max_len declared in the proto, in characters
+	maxContentLen = 4000
+	// maxMessageAttachments matches the attachment_ids max_items declared in the proto
+	maxMessageAttachments = 10
+)
+
+// checkContent rejects message content longer than the proto allows. The proto
+// bounds aren't enforced by any interceptor.
+func checkContent(content string) error {
+	if utf8.RuneCountInString(content) > maxContentLen {
+		return status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxContentLen)
+	}
+	return nil
+}
+
+// EditMessage replaces the content of one of the caller's messages and pushes
+// the edited message to the other participants' live streams
+func (s *Server) EditMessage(ctx context.Context, req *v1.EditMessageRequest) (*v1.EditMessageResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if req.GetContent() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	if err := checkContent(req.GetContent()); err != nil {
+		return nil, err
+	}
+
+	msg, err := s.loadMessage(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+	if msg.FromEmail != claims.Email {
+		return nil, status.Errorf(codes.PermissionDenied, "only the sender can edit a message")
+	}
+
+	if !msg.DeletedAt.IsZero() {
+		return nil, status.Errorf(codes.FailedPrecondition, "deleted messages cannot be edited")
+	}
+
+	edited, err := s.msgs.EditMessage(ctx, msg.ID, claims.Email, html.EscapeString(req.GetContent()), time.Now())
+	if err != nil {
+		if errors.Is(err, data.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to edit message: %v", err)
+	}
+
+	s.refreshPreview(ctx, edited)
+	s.notifyParticipants(ctx, edited, claims.Email, claims.Email, streamResponse(edited))
+
+	return &v1.EditMessageResponse{
+		MsgId:    edited.ID.Hex(),
+		Content:  edited.Content,
+		EditedAt: timestamppb.New(edited.EditedAt),
+	}, nil
+}
+
+// DeleteMessage hides a message from the caller's own view (FOR_ME) or, for
+// the sender only, replaces it with a tombstone for everyone (FOR_EVERYONE)
+func (s *Server) DeleteMessage(ctx context.Context, req *v1.DeleteMessageRequest) (*v1.DeleteMessageResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	msg, err := s.loadMessage(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetMode() {
+	case v1.DeleteMode_DELETE_MODE_FOR_ME:
+		emails, err := s.participants(ctx, msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+		}
+		if !slices.Contains(emails, claims.Email) {
+			return nil, status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+		}
+		if err := s.msgs.HideMessage(ctx, msg.ID, claims.Email); err != nil {
+			if errors.Is(err, data.ErrMessageNotFound) {
+				return nil, status.Errorf(codes.NotFound, "message not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to delete message: %v", err)
+		}
+		s.hidePreview(ctx, msg, claims.Email)
+
+	case v1.DeleteMode_DELETE_MODE_FOR_EVERYONE:
+		if msg.FromEmail != claims.Email {
+			return nil, status.Errorf(codes.PermissionDenied, "only the sender can delete a message for everyone")
+		}
+		deleted, err := s.msgs.DeleteMessageForEveryone(ctx, msg.ID, claims.Email, time.Now())
+		if err != nil {
+			if errors.Is(err, data.ErrMessageNotFound) {
+				return nil, status.Errorf(codes.NotFound, "message not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to delete message: %v", err)
+		}
+		s.refreshPreview(ctx, deleted)
+		s.notifyParticipants(ctx, deleted, claims.Email, claims.Email, streamResponse(deleted))
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported delete mode")
+	}
+
+	return &v1.DeleteMessageResponse{MsgId: msg.ID.Hex(), Mode: req.GetMode()}, nil
+}
+
+// loadMessage parses a hex message ID and fetches the message, mapping failures to gRPC statuses.
+func (s *Server) loadMessage(ctx context.Context, msgID string) (*data.Message, error) {
+	id, err := bson.ObjectIDFromHex(msgID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid msg_id")
+	}
+
+	msg, err := s.msgs.GetMessage(ctx, id)
+	if err != nil {
+		if errors.Is(err, data.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load message: %v", err)
+	}
+	return msg, nil
+}
+
+// saveMessage persists msg and records it as the last message of every
+// participant's conversation summary. A failed summary update is logged rather
+// than returned: the message itself is stored and a retry would duplicate it.
+// If the sender already stored a message with msg's ClientMsgID, that original
+// is returned with created false and nothing else happens.
+func (s *Server) saveMessage(ctx context.Context, msg *data.Message, participants []string) (saved *data.Message, created bool, err error) {
+	saved, err = s.msgs.InsertMessage(ctx, msg)
+	if errors.Is(err, data.ErrDuplicateMessage) {
+		saved, err = s.msgs.GetMessageByClientID(ctx, msg.FromEmail, msg.ClientMsgID)
+		return saved, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.convs.RecordMessage(ctx, saved, participants); err != nil {
+		log.Printf("failed to update conversations for %s: %v", saved.ID.Hex(), err)
+		return saved, true, nil
+	}
+	s.publishChats(saved, participants)
+	return saved, true, nil
+}
+
+// refreshPreview updates conversation previews showing msg after it changed
+// and tells the participants' chat list watchers.
+func (s *Server) refreshPreview(ctx context.Context, msg *data.Message) {
+	updated, err := s.convs.UpdateLastMessage(ctx, msg)
+	if err != nil {
+		log.Printf("failed to refresh previews of %s: %v", msg.ID.Hex(), err)
+		return
+	}
+	if !updated {
+		return
+	}
+	emails, err := s.participants(ctx, msg)
+	if err != nil {
+		log.Printf("failed to resolve participants of %s: %v", msg.ID.Hex(), err)
+		return
+	}
+	s.publishChats(msg, emails)
+}
+
+// hidePreview moves email's chat preview off msg after they deleted it for
+// themselves, onto the newest message they can still see, and tells their
+// chat list watchers. Failures are logged like in refreshPreview.
+func (s *Server) hidePreview(ctx context.Context, msg *data.Message, email string) {
+	var latest []*data.Message
+	var err error
+	if msg.GroupID.IsZero() {
+		latest, err = s.msgs.GetMessageHistory(ctx, email, partnerOf(msg, email), nil, 1)
+	} else {
+		latest, err = s.msgs.GetGroupHistory(ctx, msg.GroupID, email, nil, 1)
+	}
+	if err != nil {
+		log.Printf("failed to find the preview replacing %s: %v", msg.ID.Hex(), err)
+		return
+	}
+	var last *data.Message
+	if len(latest) > 0 {
+		last = latest[0]
+	}
+
+	updated, err := s.convs.ReplaceLastMessage(ctx, email, msg.ID, last)
+	if err != nil {
+		log.Printf("failed to replace preview of %s: %v", msg.ID.Hex(), err)
+		return
+	}
+	if updated {
+		s.publishChats(msg, []string{email})
+	}
+}
+
+// participants returns every email taking part in the conversation msg belongs
+// to: sender and recipient for 1-on-1 messages, current members for groups.
+func (s *Server) participants(ctx context.Context, msg *data.Message) ([]string, error) {
+	if msg.GroupID.IsZero() {
+		return []string{msg.FromEmail, msg.ToEmail}, nil
+	}
+	group, err := s.groups.GetGroup(ctx, msg.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return group.Members, nil
+}
+
+// notifyParticipants pushes resp, caused by actor, to every participant of
+// msg's conversation except the given email. Delivery is best-effort, like
+// ChatStream. The recipient of a pending message hears nothing until they
+// accept it, and participants with a block with actor hear nothing at all.
+func (s *Server) notifyParticipants(ctx context.Context, msg *data.Message, actor, except string, resp *v1.ChatStreamResponse) {
+	if s.hub == nil {
+		return
+	}
+	emails, err := s.participants(ctx, msg)
+	if err == nil {
+		emails, _, err = s.splitBlocked(ctx, actor, emails)
+	}
+	if err != nil {
+		log.Printf("failed to resolve participants of %s: %v", msg.ID.Hex(), err)
+		return
+	}
+	recipients := make([]string, 0, len(emails))
+	for _, e := range emails {
+		if msg.Pending && e == msg.ToEmail {
+			continue
+		}
+		if e != except {
+			recipients = append(recipients, e)
+		}
+	}
+	if err := s.hub.SendToUsers(recipients, resp); err != nil {
+		log.Printf("notification for %s incomplete: %v", msg.ID.Hex(), err)
+	}
+}
+
+// streamResponse wraps a stored message into a ChatStreamResponse message
+// event, mirrored into the legacy flat fields for clients that predate events.
+func streamResponse(m *data.Message) *v1.ChatStreamResponse {
+	return &v1.ChatStreamResponse{
+		MsgId:     m.ID.Hex(),
+		FromEmail: m.FromEmail,
+		Content:   m.Content,
+		SentAt:    timestamppb.New(m.SentAt),
+		GroupId:   groupIDHex(m.GroupID),
+		EditedAt:  optionalTimestamp(m.EditedAt),
+		Deleted:   !m.DeletedAt.IsZero(),
+		Event: &v1.ChatStreamResponse_Message{
+			Message: &v1.ChatMessage{
+				MsgId:         m.ID.Hex(),
+				FromEmail:     m.FromEmail,
+				Content:       m.Content,
+				SentAt:        timestamppb.New(m.SentAt),
+				GroupId:       groupIDHex(m.GroupID),
+				EditedAt:      optionalTimestamp(m.EditedAt),
+				Deleted:       !m.DeletedAt.IsZero(),
+				ReplyTo:       quoteToProto(m.ReplyTo),
+				Attachments:   attachmentsToProto(m.Attachments),
+				ClientMsgId:   m.ClientMsgID,
+				ExpiresAt:     optionalTimestamp(m.ExpiresAt),
+				System:        m.System,
+				ForwardedFrom: forwardedToProto(m.ForwardedFrom),
+				Pending:       m.Pending,
+			},
+		},
+	}
+}
+
+// historyResponse converts a stored message into a GetHistoryResponse.
+func historyResponse(m *data.Message) *v1.GetHistoryResponse {
+	return &v1.GetHistoryResponse{
+		MsgId:         m.ID.Hex(),
+		FromEmail:     m.FromEmail,
+		ToEmail:       m.ToEmail,
+		Content:       m.Content,
+		SentAt:        timestamppb.New(m.SentAt),
+		GroupId:       groupIDHex(m.GroupID),
+		EditedAt:      optionalTimestamp(m.EditedAt),
+		Deleted:       !m.DeletedAt.IsZero(),
+		ReplyTo:       quoteToProto(m.ReplyTo),
+		Attachments:   attachmentsToProto(m.Attachments),
+		ExpiresAt:     optionalTimestamp(m.ExpiresAt),
+		System:        m.System,
+		ForwardedFrom: forwardedToProto(m.ForwardedFrom),
+		Pending:       m.Pending,
+	}
+}
+
+// groupIDHex returns the hex form of a group ID, or "" for 1-on-1 messages.
+func groupIDHex(id bson.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
+// optionalTimestamp converts t to a proto timestamp, leaving zero times unset.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxPins caps the pinned messages per conversation unless PIN_LIMIT overrides it
+const defaultMaxPins = 5
+
+// PinMessage pins a message in its conversation and pushes the change to every
+// participant. Pinning an already pinned message is a no-op.
+func (s *Server) PinMessage(ctx context.Context, req *v1.PinMessageRequest) (*v1.PinMessageResponse, error) {
+	msg, email, err := s.pinTarget(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+	if !msg.PinnedAt.IsZero() {
+		return &v1.PinMessageResponse{Pinned: pinnedToProto(msg)}, nil
+	}
+	if !msg.DeletedAt.IsZero() {
+		return nil, status.Errorf(codes.FailedPrecondition, "deleted messages cannot be pinned")
+	}
+
+	updated, err := s.msgs.PinMessage(ctx, msg.ID, email, time.Now(), s.maxPins)
+	if err != nil {
+		if errors.Is(err, data.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		if errors.Is(err, data.ErrPinLimit) {
+			return nil, status.Errorf(codes.FailedPrecondition, "a conversation can have at most %d pinned messages", s.maxPins)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to pin message: %v", err)
+	}
+
+	s.notifyParticipants(ctx, updated, email, "", pinEvent(updated, email, true))
+	return &v1.PinMessageResponse{Pinned: pinnedToProto(updated)}, nil
+}
+
+// UnpinMessage unpins a message and pushes the change to every participant.
+// Unpinning a message that isn't pinned is a no-op.
+func (s *Server) UnpinMessage(ctx context.Context, req *v1.UnpinMessageRequest) (*v1.UnpinMessageResponse, error) {
+	msg, email, err := s.pinTarget(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.UnpinMessageResponse{MsgId: msg.ID.Hex()}
+	if msg.PinnedAt.IsZero() {
+		return resp, nil
+	}
+
+	updated, err := s.msgs.UnpinMessage(ctx, msg.ID)
+	if err != nil {
+		if errors.Is(err, data.ErrMessageNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unpin message: %v", err)
+	}
+
+	s.notifyParticipants(ctx, updated, email, "", pinEvent(updated, email, false))
+	return resp, nil
+}
+
+// ListPinned returns the pinned messages of the caller's chat with with_email,
+// or of a group they belong to, most recently pinned first.
+func (s *Server) ListPinned(ctx context.Context, req *v1.ListPinnedRequest) (*v1.ListPinnedResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	var pinned []*data.Message
+	var err error
+	switch {
+	case req.GetGroupId() != "":
+		group, gerr := s.memberGroup(ctx, req.GetGroupId(), claims.Email)
+		if gerr != nil {
+			return nil, gerr
+		}
+		pinned, err = s.msgs.GetGroupPinned(ctx, group.ID, claims.Email, int64(s.maxPins))
+	case req.GetWithEmail() != "":
+		pinned, err = s.msgs.GetPinned(ctx, claims.Email, normalize.Email(req.GetWithEmail()), int64(s.maxPins))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "with_email or group_id is required")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pinned messages: %v", err)
+	}
+
+	resp := &v1.ListPinnedResponse{Pinned: make([]*v1.PinnedMessage, 0, len(pinned))}
+	for _, m := range pinned {
+		resp.Pinned = append(resp.Pinned, pinnedToProto(m))
+	}
+	return resp, nil
+}
+
+// pinTarget loads a message to pin or unpin after checking the caller takes
+// part in its conversation and, in 1-on-1 chats, isn't blocked. It returns the message and the caller's email.
+func (s *Server) pinTarget(ctx context.Context, msgID string) (*data.Message, string, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, "", status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	msg, err := s.loadMessage(ctx, msgID)
+	if err != nil {
+		return nil, "", err
+	}
+	emails, err := s.participants(ctx, msg)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+	}
+	if !slices.Contains(emails, claims.Email) {
+		return nil, "", status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+	}
+	if msg.GroupID.IsZero() {
+		if err := s.requireUnblocked(ctx, claims.Email, partnerOf(msg, claims.Email)); err != nil {
+			return nil, "", err
+		}
+	}
+	return msg, claims.Email, nil
+}
+
+// pinEvent wraps a pin change into a ChatStreamResponse event.
+func pinEvent(m *data.Message, email string, pinned bool) *v1.ChatStreamResponse {
+	return &v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_Pin{
+			Pin: &v1.PinEvent{
+				MsgId:   m.ID.Hex(),
+				GroupId: groupIDHex(m.GroupID),
+				Email:   email,
+				Pinned:  pinned,
+			},
+		},
+	}
+}
+
+// pinnedToProto converts a pinned message into its API representation.
+func pinnedToProto(m *data.Message) *v1.PinnedMessage {
+	return &v1.PinnedMessage{
+		Message:  streamResponse(m).GetMessage(),
+		PinnedBy: m.PinnedBy,
+		PinnedAt: optionalTimestamp(m.PinnedAt),
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestPinnedToProto(t *testing.T) {
+	now := time.Now()
+	m := &data.Message{ID: bson.NewObjectID(), FromEmail: "alice@example.com", Content: "the link", SentAt: now, PinnedAt: now, PinnedBy: "bob@example.com"}
+
+	got := pinnedToProto(m)
+	if got.GetMessage().GetMsgId() != m.ID.Hex() || got.GetPinnedBy() != "bob@example.com" || !got.GetPinnedAt().AsTime().Equal(now) {
+		t.Fatalf("unexpected pinned message: %+v", got)
+	}
+
+	ev := pinEvent(m, "bob@example.com", false).GetPin()
+	if ev.GetMsgId() != m.ID.Hex() || ev.GetGroupId() != "" || ev.GetPinned() {
+		t.Fatalf("unexpected pin event: %+v", ev)
+	}
+}
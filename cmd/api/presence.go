@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// presenceBuffer is the per-watcher backlog of presence events before
+	// further ones are coalesced to the latest state per user.
+	presenceBuffer = 64
+	// maxPresenceEmails matches the emails max_items declared in the proto
+	maxPresenceEmails = 100
+)
+
+const (
+	// lastSeenDrainIdle is how long recordLastSeen waits for further events
+	// after being stopped
+	lastSeenDrainIdle = 200 * time.Millisecond
+	// lastSeenDrainTimeout bounds the whole drain
+	lastSeenDrainTimeout = 5 * time.Second
+)
+
+// GetPresence reports the online state and, privacy permitting, the last seen
+// time of each requested user
+func (s *Server) GetPresence(ctx context.Context, req *v1.GetPresenceRequest) (*v1.GetPresenceResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if err := checkPresenceEmails(req.GetEmails()); err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetPresenceResponse{}
+	for _, email := range req.GetEmails() {
+		p, err := s.presenceOf(ctx, claims.Email, normalize.Email(email))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load presence: %v", err)
+		}
+		if p != nil {
+			resp.Presences = append(resp.Presences, p)
+		}
+	}
+	return resp, nil
+}
+
+// WatchPresence sends the current presence of each requested user, then
+// streams their online/offline transitions as the hub sees them
+func (s *Server) WatchPresence(req *v1.WatchPresenceRequest, stream v1.ChatService_WatchPresenceServer) error {
+	claims, ok := getClaimsFromContext(stream.Context())
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if err := checkPresenceEmails(req.GetEmails()); err != nil {
+		return err
+	}
+	if s.hub == nil {
+		return status.Errorf(codes.Unavailable, "presence is not available")
+	}
+
+	// Subscribe before taking the snapshot so no transition slips in between
+	events, cancel := s.hub.SubscribePresence(presenceBuffer)
+	defer cancel()
+
+	watched := make(map[string]bool, len(req.GetEmails()))
+	for _, e := range req.GetEmails() {
+		email := normalize.Email(e)
+		p, err := s.presenceOf(stream.Context(), claims.Email, email)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load presence: %v", err)
+		}
+		if p == nil {
+			continue // unknown users are never watched
+		}
+		watched[email] = true
+		if err := stream.Send(&v1.WatchPresenceResponse{Presence: p}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send presence: %v", err)
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if !watched[ev.Email] {
+				continue
+			}
+
+			p := &v1.Presence{Email: ev.Email, Online: ev.Online}
+			if !ev.Online {
+				user, err := s.users.GetUserByEmail(stream.Context(), ev.Email)
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to load presence: %v", err)
+				}
+				if user.LastSeenVisibleTo(claims.Email) {
+					p.LastSeen = timestamppb.New(ev.At)
+				}
+			}
+			if err := stream.Send(&v1.WatchPresenceResponse{Presence: p}); err != nil {
+				return status.Errorf(codes.Internal, "failed to send presence: %v", err)
+			}
+		}
+	}
+}
+
+// checkPresenceEmails bounds a presence request: every email costs a lookup
+// and a block check, and the proto bounds aren't enforced by any interceptor.
+func checkPresenceEmails(emails []string) error {
+	if len(emails) == 0 || len(emails) > maxPresenceEmails {
+		return status.Errorf(codes.InvalidArgument, "emails must list between 1 and %d users", maxPresenceEmails)
+	}
+	return nil
+}
+
+// SetLastSeenVisibility updates who may see the caller's last seen time
+func (s *Server) SetLastSeenVisibility(ctx context.Context, req *v1.SetLastSeenVisibilityRequest) (*v1.SetLastSeenVisibilityResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	var visibility string
+	switch req.GetVisibility() {
+	case v1.LastSeenVisibility_LAST_SEEN_VISIBILITY_EVERYONE:
+		visibility = data.LastSeenEveryone
+	case v1.LastSeenVisibility_LAST_SEEN_VISIBILITY_NOBODY:
+		visibility = data.LastSeenNobody
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported visibility")
+	}
+
+	if err := s.users.SetLastSeenVisibility(ctx, claims.Email, visibility); err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update visibility: %v", err)
+	}
+
+	return &v1.SetLastSeenVisibilityResponse{Visibility: req.GetVisibility()}, nil
+}
+
+// presenceOf builds email's presence as seen by viewer, or returns nil if no
+// such user exists or either has blocked the other.
+func (s *Server) presenceOf(ctx context.Context, viewer, email string) (*v1.Presence, error) {
+	blocked, err := s.blocked(ctx, viewer, email)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, nil
+	}
+
+	user, err := s.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	p := &v1.Presence{Email: user.Email, Online: s.hub != nil && s.hub.IsOnline(user.Email)}
+	if !p.Online && user.LastSeenVisibleTo(viewer) {
+		// The hub knows about disconnects recordLastSeen may not have stored yet
+		lastSeen := user.LastSeen
+		if s.hub != nil {
+			if at, ok := s.hub.OfflineSince(user.Email); ok && at.After(lastSeen) {
+				lastSeen = at
+			}
+		}
+		p.LastSeen = optionalTimestamp(lastSeen)
+	}
+	return p, nil
+}
+
+// recordLastSeen persists last_seen whenever the hub reports a user going
+// offline. It runs until ctx is cancelled, then drains the events still
+// arriving so the disconnects of a graceful shutdown aren't lost.
+func (s *Server) recordLastSeen(ctx context.Context) {
+	events, cancel := s.hub.SubscribePresence(presenceBuffer)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.drainLastSeen(events)
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			s.storeLastSeen(ctx, ev)
+		}
+	}
+}
+
+// drainLastSeen stores offline events until none has arrived for
+// lastSeenDrainIdle or lastSeenDrainTimeout has passed.
+func (s *Server) drainLastSeen(events <-chan PresenceEvent) {
+	ctx, stop := context.WithTimeout(context.Background(), lastSeenDrainTimeout)
+	defer stop()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			s.storeLastSeen(ctx, ev)
+		case <-time.After(lastSeenDrainIdle):
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// storeLastSeen persists the time of an offline event.
+func (s *Server) storeLastSeen(ctx context.Context, ev PresenceEvent) {
+	if ev.Online {
+		return
+	}
+	if err := s.users.SetLastSeen(ctx, ev.Email, ev.At); err != nil {
+		log.Printf("failed to record last seen for %s: %v", ev.Email, err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLastSeen is a UsersStore that keeps users and last_seen writes in memory.
+type fakeLastSeen struct {
+	UsersStore
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func (f *fakeLastSeen) SetLastSeen(_ context.Context, email string, at time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seen[email] = at
+	return nil
+}
+
+func (f *fakeLastSeen) GetUserByEmail(_ context.Context, email string) (*data.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &data.User{Email: email, LastSeen: f.seen[email]}, nil
+}
+
+func TestRecordLastSeen_DrainsOnStop(t *testing.T) {
+	hub := NewConnectionHub()
+	users := &fakeLastSeen{seen: map[string]time.Time{}}
+	s := &Server{hub: hub, users: users}
+
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.recordLastSeen(ctx)
+		close(done)
+	}()
+	// wait for the subscription before producing events
+	for {
+		hub.mu.RLock()
+		n := len(hub.watchers)
+		hub.mu.RUnlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// more disconnects than the buffer holds, right before shutdown
+	for i := 0; i < presenceBuffer+10; i++ {
+		email := fmt.Sprintf("user%d@example.com", i)
+		hub.Unregister(email, hub.Register(email, &fakeSender{}))
+	}
+	id := hub.Register("last@example.com", &fakeSender{})
+	hub.Unregister("last@example.com", id)
+	stop()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("recordLastSeen did not stop")
+	}
+	users.mu.Lock()
+	defer users.mu.Unlock()
+	if users.seen["last@example.com"].IsZero() || len(users.seen) != presenceBuffer+11 {
+		t.Fatalf("expected every disconnect to be stored, got %d", len(users.seen))
+	}
+}
+
+func TestPresenceOf_UsesHubOfflineTime(t *testing.T) {
+	hub := NewConnectionHub()
+	users := &fakeLastSeen{seen: map[string]time.Time{}}
+	s := &Server{hub: hub, users: users, blocks: &fakeBlocks{}}
+
+	// bob disconnects but recordLastSeen hasn't stored it yet
+	hub.Unregister("bob@example.com", hub.Register("bob@example.com", &fakeSender{}))
+
+	p, err := s.presenceOf(context.Background(), "alice@example.com", "bob@example.com")
+	if err != nil {
+		t.Fatalf("presenceOf failed: %v", err)
+	}
+	if p.GetOnline() || p.GetLastSeen() == nil {
+		t.Fatalf("expected offline presence with a last seen time, got %v", p)
+	}
+}
+
+func TestGetPresence_BoundsEmails(t *testing.T) {
+	s := &Server{}
+	emails := make([]string, maxPresenceEmails+1)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("user%d@example.com", i)
+	}
+	for _, req := range []*v1.GetPresenceRequest{{}, {Emails: emails}} {
+		if _, err := s.GetPresence(claimsContext("alice@example.com"), req); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("GetPresence with %d emails: got %v, want InvalidArgument", len(req.GetEmails()), err)
+		}
+	}
+}
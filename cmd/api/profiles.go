@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Bounds declared in the proto; no interceptor enforces them.
+const (
+	// maxDisplayNameLen and maxStatusLen are in characters, before escaping
+	maxDisplayNameLen = 64
+	maxStatusLen      = 140
+	// maxBatchProfiles caps BatchGetProfiles emails
+	maxBatchProfiles = 100
+)
+
+// GetProfile returns a user's profile. Users who blocked or were blocked by
+// the caller look like they don't exist
+func (s *Server) GetProfile(ctx context.Context, req *v1.GetProfileRequest) (*v1.GetProfileResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	email := normalize.Email(req.GetEmail())
+	blocked, err := s.blocked(ctx, claims.Email, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+	if blocked {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	user, err := s.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load profile: %v", err)
+	}
+	return &v1.GetProfileResponse{Profile: profileToProto(user)}, nil
+}
+
+// UpdateProfile changes the fields set in the request and leaves the others
+// as they are
+func (s *Server) UpdateProfile(ctx context.Context, req *v1.UpdateProfileRequest) (*v1.UpdateProfileResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	var update data.ProfileUpdate
+	if req.DisplayName != nil {
+		name := strings.TrimSpace(req.GetDisplayName())
+		if utf8.RuneCountInString(name) > maxDisplayNameLen {
+			return nil, status.Errorf(codes.InvalidArgument, "display_name must be at most %d characters", maxDisplayNameLen)
+		}
+		name = html.EscapeString(name)
+		update.DisplayName = &name
+	}
+	if req.Status != nil {
+		text := strings.TrimSpace(req.GetStatus())
+		if utf8.RuneCountInString(text) > maxStatusLen {
+			return nil, status.Errorf(codes.InvalidArgument, "status must be at most %d characters", maxStatusLen)
+		}
+		text = html.EscapeString(text)
+		update.StatusText = &text
+	}
+	if req.TimeZone != nil {
+		if err := validTimeZone(req.GetTimeZone()); err != nil {
+			return nil, err
+		}
+		tz := req.GetTimeZone()
+		update.TimeZone = &tz
+	}
+	if req.AvatarAttachmentId != nil {
+		var avatarID bson.ObjectID
+		if id := req.GetAvatarAttachmentId(); id != "" {
+			att, err := s.loadAttachment(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if att.OwnerEmail != claims.Email {
+				return nil, status.Errorf(codes.PermissionDenied, "avatar was not uploaded by the caller")
+			}
+			if !strings.HasPrefix(att.ContentType, "image/") {
+				return nil, status.Errorf(codes.InvalidArgument, "avatar must be an image")
+			}
+			avatarID = att.ID
+		}
+		update.AvatarID = &avatarID
+	}
+
+	user, err := s.users.UpdateProfile(ctx, claims.Email, update)
+	if err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
+	}
+	return &v1.UpdateProfileResponse{Profile: profileToProto(user)}, nil
+}
+
+// BatchGetProfiles returns the profiles of the requested users, leaving out
+// unknown users and those blocked either way
+func (s *Server) BatchGetProfiles(ctx context.Context, req *v1.BatchGetProfilesRequest) (*v1.BatchGetProfilesResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if n := len(req.GetEmails()); n == 0 || n > maxBatchProfiles {
+		return nil, status.Errorf(codes.InvalidArgument, "emails must list between 1 and %d users", maxBatchProfiles)
+	}
+
+	seen := make(map[string]bool, len(req.GetEmails()))
+	requested := make([]string, 0, len(req.GetEmails()))
+	for _, e := range req.GetEmails() {
+		email := normalize.Email(e)
+		if !seen[email] {
+			seen[email] = true
+			requested = append(requested, email)
+		}
+	}
+	emails, _, err := s.splitBlocked(ctx, claims.Email, requested)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+
+	users, err := s.users.GetUsersByEmails(ctx, emails)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load profiles: %v", err)
+	}
+	resp := &v1.BatchGetProfilesResponse{Profiles: make([]*v1.Profile, 0, len(users))}
+	for _, u := range users {
+		resp.Profiles = append(resp.Profiles, profileToProto(u))
+	}
+	return resp, nil
+}
+
+// validTimeZone checks that tz is an IANA time zone name; an empty tz clears
+// the setting and is always valid.
+func validTimeZone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	// LoadLocation also accepts "Local", which means nothing to other users
+	if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+		return status.Errorf(codes.InvalidArgument, "unknown time zone %q", tz)
+	}
+	return nil
+}
+
+// profileToProto converts a user into their public profile.
+func profileToProto(u *data.User) *v1.Profile {
+	return &v1.Profile{
+		Email:              u.Email,
+		DisplayName:        u.DisplayName,
+		AvatarAttachmentId: avatarIDHex(u.AvatarID),
+		Status:             u.StatusText,
+		TimeZone:           u.TimeZone,
+	}
+}
+
+// profileSnippet converts a chat partner into the profile snippet shown in
+// chat lists, or nil if the partner is unknown.
+func profileSnippet(u *data.User) *v1.ProfileSnippet {
+	if u == nil {
+		return nil
+	}
+	return &v1.ProfileSnippet{
+		DisplayName:        u.DisplayName,
+		AvatarAttachmentId: avatarIDHex(u.AvatarID),
+	}
+}
+
+// avatarIDHex returns the hex form of an avatar attachment ID, or "" if the
+// user has no avatar.
+func avatarIDHex(id bson.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidTimeZone(t *testing.T) {
+	for _, tz := range []string{"", "UTC", "Europe/Berlin", "America/New_York"} {
+		if err := validTimeZone(tz); err != nil {
+			t.Errorf("validTimeZone(%q) = %v, want nil", tz, err)
+		}
+	}
+	for _, tz := range []string{"Local", "Mars/Olympus", "../etc/passwd"} {
+		if err := validTimeZone(tz); err == nil {
+			t.Errorf("validTimeZone(%q) accepted an invalid zone", tz)
+		}
+	}
+}
+
+func TestChatProfileSnippet(t *testing.T) {
+	avatar := bson.NewObjectID()
+	partner := &data.User{Email: "bob@example.com", DisplayName: "Bob", AvatarID: avatar}
+
+	chat := chatToProto(&data.Conversation{PartnerEmail: "bob@example.com"}, "", partner)
+	if chat.GetProfile().GetDisplayName() != "Bob" || chat.GetProfile().GetAvatarAttachmentId() != avatar.Hex() {
+		t.Fatalf("unexpected profile snippet: %+v", chat.GetProfile())
+	}
+
+	group := chatToProto(&data.Conversation{GroupID: bson.NewObjectID()}, "team", nil)
+	if group.GetProfile() != nil {
+		t.Fatalf("group chats should have no profile snippet")
+	}
+
+	if p := profileToProto(&data.User{Email: "ada@example.com"}); p.GetAvatarAttachmentId() != "" {
+		t.Fatalf("user without avatar got avatar id %q", p.GetAvatarAttachmentId())
+	}
+}
+
+func TestProfileBounds(t *testing.T) {
+	s := &Server{}
+	ctx := claimsContext("alice@example.com")
+
+	name := strings.Repeat("é", maxDisplayNameLen+1)
+	if _, err := s.UpdateProfile(ctx, &v1.UpdateProfileRequest{DisplayName: &name}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateProfile with a long display name: got %v, want InvalidArgument", err)
+	}
+	text := strings.Repeat("a", maxStatusLen+1)
+	if _, err := s.UpdateProfile(ctx, &v1.UpdateProfileRequest{Status: &text}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateProfile with a long status: got %v, want InvalidArgument", err)
+	}
+
+	emails := make([]string, maxBatchProfiles+1)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("user%d@example.com", i)
+	}
+	for _, req := range []*v1.BatchGetProfilesRequest{{}, {Emails: emails}} {
+		if _, err := s.BatchGetProfiles(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("BatchGetProfiles with %d emails: got %v, want InvalidArgument", len(req.GetEmails()), err)
+		}
+	}
+}
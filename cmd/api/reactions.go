@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxEmojiLen matches the emoji max_len declared in the proto, in characters
+	maxEmojiLen = 16
+	// maxReactionsPerUser caps how many different emojis one user can put on
+	// a message, so reactions can't grow a message without bound
+	maxReactionsPerUser = 10
+)
+
+// React adds the caller's emoji reaction to a message and pushes the change to
+// every participant, including the caller's other devices
+func (s *Server) React(ctx context.Context, req *v1.ReactRequest) (*v1.ReactResponse, error) {
+	msg, email, err := s.changeReaction(ctx, req.GetMsgId(), req.GetEmoji(), true)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReactResponse{MsgId: msg.ID.Hex(), Reactions: reactionCounts(msg.Reactions, email)}, nil
+}
+
+// Unreact removes the caller's emoji reaction from a message and pushes the
+// change to every participant
+func (s *Server) Unreact(ctx context.Context, req *v1.UnreactRequest) (*v1.UnreactResponse, error) {
+	msg, email, err := s.changeReaction(ctx, req.GetMsgId(), req.GetEmoji(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UnreactResponse{MsgId: msg.ID.Hex(), Reactions: reactionCounts(msg.Reactions, email)}, nil
+}
+
+// changeReaction adds or removes the caller's reaction after checking they take
+// part in the message's conversation and, in 1-on-1 chats, aren't blocked. It returns the updated message and the
+// caller's email.
+func (s *Server) changeReaction(ctx context.Context, msgID, emoji string, add bool) (*data.Message, string, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, "", status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	// The proto bounds aren't enforced by any interceptor. Removing is left
+	// lenient so reactions stored before the check can still be taken back
+	if n := utf8.RuneCountInString(emoji); n == 0 || n > maxEmojiLen || (add && !isEmoji(emoji)) {
+		return nil, "", status.Errorf(codes.InvalidArgument, "emoji must be an emoji of at most %d characters", maxEmojiLen)
+	}
+
+	msg, err := s.loadMessage(ctx, msgID)
+	if err != nil {
+		return nil, "", err
+	}
+	emails, err := s.participants(ctx, msg)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+	}
+	if !slices.Contains(emails, claims.Email) {
+		return nil, "", status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+	}
+	if msg.GroupID.IsZero() {
+		if err := s.requireUnblocked(ctx, claims.Email, partnerOf(msg, claims.Email)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var updated *data.Message
+	if add {
+		if !msg.DeletedAt.IsZero() {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "deleted messages cannot be reacted to")
+		}
+		updated, err = s.msgs.React(ctx, msg.ID, claims.Email, emoji, maxReactionsPerUser)
+	} else {
+		updated, err = s.msgs.Unreact(ctx, msg.ID, claims.Email, emoji)
+	}
+	if err != nil {
+		if errors.Is(err, data.ErrMessageNotFound) {
+			return nil, "", status.Errorf(codes.NotFound, "message not found")
+		}
+		if errors.Is(err, data.ErrReactionLimit) {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "at most %d different reactions per message", maxReactionsPerUser)
+		}
+		return nil, "", status.Errorf(codes.Internal, "failed to update reactions: %v", err)
+	}
+
+	s.notifyParticipants(ctx, updated, claims.Email, "", &v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_Reaction{
+			Reaction: &v1.ReactionEvent{
+				MsgId:   updated.ID.Hex(),
+				GroupId: groupIDHex(updated.GroupID),
+				Email:   claims.Email,
+				Emoji:   emoji,
+				Added:   add,
+			},
+		},
+	})
+
+	return updated, claims.Email, nil
+}
+
+// reactionCounts aggregates reactions per emoji in order of first use, marking
+// the emojis viewer reacted with.
+func reactionCounts(reactions []data.Reaction, viewer string) []*v1.ReactionCount {
+	var counts []*v1.ReactionCount
+	byEmoji := make(map[string]*v1.ReactionCount)
+	for _, r := range reactions {
+		c, ok := byEmoji[r.Emoji]
+		if !ok {
+			c = &v1.ReactionCount{Emoji: r.Emoji}
+			byEmoji[r.Emoji] = c
+			counts = append(counts, c)
+		}
+		c.Count++
+		if r.Email == viewer {
+			c.Reacted = true
+		}
+	}
+	return counts
+}
+
+// isEmoji reports whether s is made of emoji only: pictographic symbols plus
+// the modifiers, joiners, variation selectors and tags that combine them into
+// one glyph. Digits, '#' and '*' only count as part of a keycap sequence.
+func isEmoji(s string) bool {
+	keycap := strings.ContainsRune(s, '\u20E3')
+	symbols := 0
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.So, r):
+			symbols++
+		case unicode.Is(unicode.Sk, r) && r >= 0x1F3FB && r <= 0x1F3FF:
+			// skin tone modifiers
+		case r == '\u200D', r == '\u20E3', r >= '\uFE00' && r <= '\uFE0F', r >= 0xE0020 && r <= 0xE007F:
+			// zero width joiner, keycap, variation selectors and tags
+		case keycap && (r >= '0' && r <= '9' || r == '#' || r == '*'):
+			symbols++
+		default:
+			return false
+		}
+	}
+	return symbols > 0
+}
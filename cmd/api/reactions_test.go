@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReactionCounts(t *testing.T) {
+	reactions := []data.Reaction{
+		{Email: "alice@example.com", Emoji: "👍"},
+		{Email: "bob@example.com", Emoji: "🎉"},
+		{Email: "bob@example.com", Emoji: "👍"},
+	}
+
+	counts := reactionCounts(reactions, "bob@example.com")
+	if len(counts) != 2 {
+		t.Fatalf("expected 2 emojis, got %d", len(counts))
+	}
+	if counts[0].Emoji != "👍" || counts[0].Count != 2 || !counts[0].Reacted {
+		t.Fatalf("unexpected first count: %v", counts[0])
+	}
+	if counts[1].Emoji != "🎉" || counts[1].Count != 1 || !counts[1].Reacted {
+		t.Fatalf("unexpected second count: %v", counts[1])
+	}
+
+	if counts := reactionCounts(reactions, "carol@example.com"); counts[0].Reacted {
+		t.Fatalf("carol did not react")
+	}
+}
+
+func TestIsEmoji(t *testing.T) {
+	for _, s := range []string{"👍", "👍🏽", "❤️", "👩‍💻", "🇳🇬", "1️⃣", "🏴󠁧󠁢󠁳󠁣󠁴󠁿"} {
+		if !isEmoji(s) {
+			t.Errorf("isEmoji(%q) = false, want true", s)
+		}
+	}
+	for _, s := range []string{"", "a", "ok👍", "1", "‍", "<b>"} {
+		if isEmoji(s) {
+			t.Errorf("isEmoji(%q) = true, want false", s)
+		}
+	}
+}
+
+func TestChangeReaction_Validates(t *testing.T) {
+	s, _, _ := blockedGroupServer(t)
+	group := s.groups.(*fakeGroups).group
+	msg := &data.Message{ID: bson.NewObjectID(), FromEmail: "bob@example.com", GroupID: group.ID, Content: "plan"}
+	s.msgs.(*fakeGroupMsgs).msg = msg
+
+	for _, emoji := range []string{"", "nope", strings.Repeat("👍", maxEmojiLen+1)} {
+		if _, err := s.React(claimsContext("alice@example.com"), &v1.ReactRequest{MsgId: msg.ID.Hex(), Emoji: emoji}); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("React(%q): got %v, want InvalidArgument", emoji, err)
+		}
+	}
+	if len(msg.Reactions) != 0 {
+		t.Fatalf("expected no reactions, got %+v", msg.Reactions)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MarkRead records that the caller has read msg's conversation up to msg and
+// relays a read receipt to the other participants' live streams
+func (s *Server) MarkRead(ctx context.Context, req *v1.MarkReadRequest) (*v1.MarkReadResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	msg, err := s.loadMessage(ctx, req.GetMsgId())
+	if err != nil {
+		return nil, err
+	}
+	emails, err := s.participants(ctx, msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+	}
+	if !slices.Contains(emails, claims.Email) {
+		return nil, status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+	}
+
+	// The watermark is the message's sent time, so everything before it counts as read too.
+	// Messages between the old and new watermark no longer count as unread
+	var prev time.Time
+	var newlyRead int64
+	if msg.GroupID.IsZero() {
+		partner := partnerOf(msg, claims.Email)
+		prev, err = s.convs.MarkRead(ctx, claims.Email, partner, msg.SentAt)
+		if err == nil && msg.SentAt.After(prev) {
+			newlyRead, err = s.msgs.CountReceived(ctx, claims.Email, partner, prev, msg.SentAt)
+		}
+		if err == nil && newlyRead > 0 {
+			err = s.convs.ReduceUnread(ctx, claims.Email, partner, newlyRead)
+		}
+	} else {
+		prev, err = s.convs.MarkGroupRead(ctx, claims.Email, msg.GroupID, msg.SentAt)
+		if err == nil && msg.SentAt.After(prev) {
+			newlyRead, err = s.msgs.CountGroupReceived(ctx, claims.Email, msg.GroupID, prev, msg.SentAt)
+		}
+		if err == nil && newlyRead > 0 {
+			err = s.convs.ReduceGroupUnread(ctx, claims.Email, msg.GroupID, newlyRead)
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark read: %v", err)
+	}
+	if newlyRead > 0 {
+		s.publishChats(msg, []string{claims.Email})
+	}
+
+	readAt := time.Now()
+	s.notifyParticipants(ctx, msg, claims.Email, claims.Email, &v1.ChatStreamResponse{
+		Event: &v1.ChatStreamResponse_ReadReceipt{
+			ReadReceipt: &v1.ReadReceipt{
+				ReaderEmail: claims.Email,
+				MsgId:       msg.ID.Hex(),
+				GroupId:     groupIDHex(msg.GroupID),
+				ReadUpTo:    timestamppb.New(msg.SentAt),
+				ReadAt:      timestamppb.New(readAt),
+			},
+		},
+	})
+
+	return &v1.MarkReadResponse{MsgId: msg.ID.Hex(), ReadAt: timestamppb.New(readAt)}, nil
+}
+
+// partnerOf returns the other side of a 1-on-1 message from email's point of view.
+func partnerOf(msg *data.Message, email string) string {
+	if msg.FromEmail == email {
+		return msg.ToEmail
+	}
+	return msg.FromEmail
+}
+
+// readWatermarks maps participant emails to their read watermarks.
+type readWatermarks map[string]time.Time
+
+// readBy reports whether every participant other than the sender has read m.
+func (w readWatermarks) readBy(m *data.Message, participants []string) bool {
+	for _, email := range participants {
+		if email == m.FromEmail {
+			continue
+		}
+		if w[email].Before(m.SentAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// oneToOneWatermarks loads both sides' read watermarks for a 1-on-1 chat.
+func (s *Server) oneToOneWatermarks(ctx context.Context, a, b string) (readWatermarks, error) {
+	aRead, err := s.convs.GetReadAt(ctx, a, b)
+	if err != nil {
+		return nil, err
+	}
+	bRead, err := s.convs.GetReadAt(ctx, b, a)
+	if err != nil {
+		return nil, err
+	}
+	return readWatermarks{a: aRead, b: bRead}, nil
+}
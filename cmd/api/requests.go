@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultRequestsLimit is used when ListMessageRequests is called without a limit
+	defaultRequestsLimit = 50
+	// maxAcceptedReplay caps how many pending messages accepting a request
+	// pushes to live streams; older ones arrive through redelivery
+	maxAcceptedReplay = 500
+	// pendingPageSize is how many pending messages acceptRequest reads at once
+	pendingPageSize = 500
+)
+
+// ListMessageRequests returns the caller's pending message requests: users
+// who messaged them without being a contact, most recent first.
+func (s *Server) ListMessageRequests(ctx context.Context, req *v1.ListMessageRequestsRequest) (*v1.ListMessageRequestsResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	var limit int64 = int64(req.GetLimit())
+	if limit == 0 {
+		limit = defaultRequestsLimit
+	}
+
+	requests, err := s.contacts.ListRequests(ctx, claims.Email, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list message requests: %v", err)
+	}
+	resp := &v1.ListMessageRequestsResponse{Requests: make([]*v1.MessageRequest, 0, len(requests))}
+	for _, r := range requests {
+		resp.Requests = append(resp.Requests, &v1.MessageRequest{
+			FromEmail:     r.ContactEmail,
+			LastMessage:   r.LastMessage,
+			LastMessageAt: timestamppb.New(r.LastMessageAt),
+			MessageCount:  int32(r.MessageCount),
+			RequestedAt:   timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// RespondToMessageRequest accepts, declines or blocks the caller's pending
+// request from from_email. Accepting delivers the pending messages.
+func (s *Server) RespondToMessageRequest(ctx context.Context, req *v1.RespondToMessageRequestRequest) (*v1.RespondToMessageRequestResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	from := normalize.Email(req.GetFromEmail())
+	resp := &v1.RespondToMessageRequestResponse{FromEmail: from, Action: req.GetAction()}
+
+	contact, err := s.contacts.GetContact(ctx, claims.Email, from)
+	if err != nil {
+		if errors.Is(err, data.ErrContactNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message request not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load message request: %v", err)
+	}
+	if contact.Status != data.ContactPending {
+		return nil, status.Errorf(codes.NotFound, "message request not found")
+	}
+
+	switch req.GetAction() {
+	case v1.MessageRequestAction_MESSAGE_REQUEST_ACTION_ACCEPT:
+		delivered, err := s.acceptRequest(ctx, claims.Email, from)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to accept message request: %v", err)
+		}
+		resp.Delivered = int32(delivered)
+
+	case v1.MessageRequestAction_MESSAGE_REQUEST_ACTION_BLOCK:
+		if _, err := s.blocks.Block(ctx, claims.Email, from); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to block user: %v", err)
+		}
+		fallthrough
+	case v1.MessageRequestAction_MESSAGE_REQUEST_ACTION_DECLINE:
+		if err := s.contacts.Decline(ctx, claims.Email, from); err != nil && !errors.Is(err, data.ErrContactNotFound) {
+			return nil, status.Errorf(codes.Internal, "failed to decline message request: %v", err)
+		}
+		if err := s.groups.DropInvites(ctx, claims.Email, from); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decline group invites: %v", err)
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported action")
+	}
+	return resp, nil
+}
+
+// messageGate runs before a 1-on-1 message from fromEmail to toEmail is
+// stored. Writing to someone makes them the sender's contact, which also
+// accepts any request they sent the sender. It reports whether the message is
+// a request toEmail has to accept before it is delivered.
+func (s *Server) messageGate(ctx context.Context, fromEmail, toEmail string) (bool, error) {
+	own, err := s.contacts.GetContact(ctx, fromEmail, toEmail)
+	if err != nil && !errors.Is(err, data.ErrContactNotFound) {
+		return false, err
+	}
+	switch {
+	case own == nil:
+		if _, err := s.contacts.Accept(ctx, fromEmail, toEmail); err != nil {
+			return false, err
+		}
+	case own.Status != data.ContactAccepted:
+		// Replying to a request, even a declined one, accepts it
+		if _, err := s.acceptRequest(ctx, fromEmail, toEmail); err != nil {
+			return false, err
+		}
+	}
+
+	accepted, err := s.acceptsFrom(ctx, toEmail, fromEmail)
+	if err != nil {
+		return false, err
+	}
+	return !accepted, nil
+}
+
+// acceptsFrom reports whether owner takes messages from sender. Chats that
+// predate contacts count as accepted: the first time one is seen, sender is
+// added as owner's contact.
+func (s *Server) acceptsFrom(ctx context.Context, owner, sender string) (bool, error) {
+	contact, err := s.contacts.GetContact(ctx, owner, sender)
+	if err == nil {
+		return contact.Status == data.ContactAccepted, nil
+	}
+	if !errors.Is(err, data.ErrContactNotFound) {
+		return false, err
+	}
+
+	conv, err := s.convs.GetConversation(ctx, owner, sender)
+	if errors.Is(err, data.ErrConversationNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if conv.LastMessageAt.IsZero() {
+		return false, nil
+	}
+	if _, err := s.contacts.Accept(ctx, owner, sender); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// acceptRequest makes requester owner's contact and turns their pending
+// messages into regular ones: they are counted in owner's chat list and
+// pushed to owner's streams. Group invites from requester are accepted too.
+// It returns how many messages were turned into regular ones.
+func (s *Server) acceptRequest(ctx context.Context, owner, requester string) (int, error) {
+	// ClearPending turns every pending message into a regular one, so all of
+	// them are read to keep owner's unread count and preview right
+	var pending []*data.Message
+	var cursor *data.HistoryCursor
+	for {
+		page, err := s.msgs.GetPending(ctx, requester, owner, cursor, pendingPageSize)
+		if err != nil {
+			return 0, err
+		}
+		pending = append(pending, page...)
+		if len(page) < pendingPageSize {
+			break
+		}
+		cursor = data.CursorAt(page[len(page)-1], true)
+	}
+	if _, err := s.contacts.Accept(ctx, owner, requester); err != nil {
+		return 0, err
+	}
+	if err := s.msgs.ClearPending(ctx, requester, owner); err != nil {
+		return 0, err
+	}
+	if _, err := s.groups.AcceptInvites(ctx, owner, requester); err != nil {
+		return 0, err
+	}
+
+	for i, m := range pending {
+		m.Pending = false
+		if err := s.convs.RecordMessage(ctx, m, []string{owner}); err != nil {
+			log.Printf("failed to update conversations for %s: %v", m.ID.Hex(), err)
+		}
+		// Only the newest messages are pushed
+		if s.hub != nil && len(pending)-i <= maxAcceptedReplay {
+			if err := s.hub.SendToUser(owner, streamResponse(m)); err != nil {
+				log.Printf("delivery of accepted %s failed (or user offline): %v", m.ID.Hex(), err)
+			}
+		}
+	}
+	if len(pending) > 0 {
+		s.publishChats(pending[len(pending)-1], []string{owner})
+	}
+	return len(pending), nil
+}
+
+// recordRequest adds a freshly stored pending message to its recipient's
+// message requests. Failures are logged: the message itself is stored.
+func (s *Server) recordRequest(ctx context.Context, msg *data.Message) {
+	if err := s.contacts.RecordRequest(ctx, msg.ToEmail, msg.FromEmail, msg); err != nil {
+		log.Printf("failed to record message request %s: %v", msg.ID.Hex(), err)
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fakeContacts is an in-memory ContactsStore keyed by "owner|contact".
+type fakeContacts struct {
+	contacts map[string]*data.Contact
+}
+
+func (f *fakeContacts) GetContact(_ context.Context, owner, contact string) (*data.Contact, error) {
+	if c, ok := f.contacts[owner+"|"+contact]; ok {
+		return c, nil
+	}
+	return nil, data.ErrContactNotFound
+}
+
+func (f *fakeContacts) Accept(_ context.Context, owner, contact string) (string, error) {
+	if f.contacts == nil {
+		f.contacts = make(map[string]*data.Contact)
+	}
+	var prev string
+	if c, ok := f.contacts[owner+"|"+contact]; ok {
+		prev = c.Status
+	}
+	f.contacts[owner+"|"+contact] = &data.Contact{OwnerEmail: owner, ContactEmail: contact, Status: data.ContactAccepted}
+	return prev, nil
+}
+
+func (f *fakeContacts) RecordRequest(_ context.Context, owner, contact string, msg *data.Message) error {
+	if f.contacts == nil {
+		f.contacts = make(map[string]*data.Contact)
+	}
+	c, ok := f.contacts[owner+"|"+contact]
+	if !ok {
+		c = &data.Contact{OwnerEmail: owner, ContactEmail: contact, Status: data.ContactPending}
+		f.contacts[owner+"|"+contact] = c
+	}
+	c.LastMessage = msg.Content
+	c.MessageCount++
+	return nil
+}
+
+func (f *fakeContacts) Decline(_ context.Context, owner, contact string) error {
+	c, ok := f.contacts[owner+"|"+contact]
+	if !ok || c.Status != data.ContactPending {
+		return data.ErrContactNotFound
+	}
+	c.Status = data.ContactDeclined
+	return nil
+}
+
+func (f *fakeContacts) ListRequests(context.Context, string, int64) ([]*data.Contact, error) {
+	return nil, nil
+}
+
+// fakeConvs serves GetConversation from memory; other methods are unused.
+type fakeConvs struct {
+	ConversationsStore
+	convs map[string]*data.Conversation
+}
+
+func (f *fakeConvs) GetConversation(_ context.Context, owner, partner string) (*data.Conversation, error) {
+	if c, ok := f.convs[owner+"|"+partner]; ok {
+		return c, nil
+	}
+	return nil, data.ErrConversationNotFound
+}
+
+func TestMessageGate(t *testing.T) {
+	ctx := context.Background()
+	contacts := &fakeContacts{}
+	convs := &fakeConvs{convs: map[string]*data.Conversation{
+		// carol and dave chatted before contacts existed
+		"dave@example.com|carol@example.com": {LastMessageAt: time.Now()},
+	}}
+	s := &Server{contacts: contacts, convs: convs}
+
+	// a stranger's first message is a request, and makes the recipient the
+	// stranger's contact so replies go straight through
+	pending, err := s.messageGate(ctx, "alice@example.com", "bob@example.com")
+	if err != nil || !pending {
+		t.Fatalf("expected a pending first message, got %v, %v", pending, err)
+	}
+	if c, _ := contacts.GetContact(ctx, "alice@example.com", "bob@example.com"); c == nil || c.Status != data.ContactAccepted {
+		t.Fatalf("expected bob to become alice's contact, got %+v", c)
+	}
+
+	// existing conversations are grandfathered in
+	pending, err = s.messageGate(ctx, "carol@example.com", "dave@example.com")
+	if err != nil || pending {
+		t.Fatalf("expected an existing chat to go through, got %v, %v", pending, err)
+	}
+	if c, _ := contacts.GetContact(ctx, "dave@example.com", "carol@example.com"); c == nil || c.Status != data.ContactAccepted {
+		t.Fatalf("expected carol to be backfilled as dave's contact, got %+v", c)
+	}
+}
+
+func TestRelayTyping_Stranger(t *testing.T) {
+	hub := NewConnectionHub()
+	bob := &fakeSender{}
+	hub.Register("bob@example.com", bob)
+
+	s := &Server{hub: hub, blocks: &fakeBlocks{}, contacts: &fakeContacts{}, convs: &fakeConvs{}}
+	s.relayTyping(context.Background(), "alice@example.com", &v1.TypingEvent{ToEmail: "bob@example.com"}, true)
+	if bob.last != nil {
+		t.Fatalf("expected no typing event from a stranger, got %v", bob.last)
+	}
+}
+
+func TestGroupInvite_ThroughRequests(t *testing.T) {
+	msgs := &fakeGroupMsgs{}
+	s := &Server{
+		blocks:   &fakeBlocks{},
+		contacts: &fakeContacts{},
+		users:    existingUsers{},
+		groups:   &fakeGroups{},
+		msgs:     msgs,
+		convs:    &recordingConvs{ConversationsStore: &fakeConvs{}},
+	}
+
+	// bob doesn't take messages from alice yet, so he is invited, not added
+	resp, err := s.CreateGroup(claimsContext("alice@example.com"), &v1.CreateGroupRequest{Name: "<trip>", MemberEmails: []string{"bob@example.com"}})
+	if err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	group := resp.GetGroup()
+	if len(group.GetMemberEmails()) != 1 || len(group.GetInvitedEmails()) != 1 || group.GetInvitedEmails()[0] != "bob@example.com" {
+		t.Fatalf("expected bob to be invited, got members %v, invited %v", group.GetMemberEmails(), group.GetInvitedEmails())
+	}
+	if len(msgs.inserted) != 1 || !msgs.inserted[0].Pending || !msgs.inserted[0].System || msgs.inserted[0].Content != "alice@example.com invited you to join &lt;trip&gt;" {
+		t.Fatalf("expected a pending invite message, got %+v", msgs.inserted)
+	}
+	if c, _ := s.contacts.GetContact(context.Background(), "bob@example.com", "alice@example.com"); c == nil || c.Status != data.ContactPending {
+		t.Fatalf("expected the invite in bob's message requests, got %+v", c)
+	}
+
+	// inviting again doesn't send another request
+	if _, err := s.AddMember(claimsContext("alice@example.com"), &v1.AddMemberRequest{GroupId: group.GetGroupId(), Email: "bob@example.com"}); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+	if len(msgs.inserted) != 1 {
+		t.Fatalf("expected a single invite message, got %d", len(msgs.inserted))
+	}
+
+	// accepting the request joins the group
+	_, err = s.RespondToMessageRequest(claimsContext("bob@example.com"), &v1.RespondToMessageRequestRequest{FromEmail: "alice@example.com", Action: v1.MessageRequestAction_MESSAGE_REQUEST_ACTION_ACCEPT})
+	if err != nil {
+		t.Fatalf("RespondToMessageRequest failed: %v", err)
+	}
+	joined := s.groups.(*fakeGroups).group
+	if !joined.HasMember("bob@example.com") || len(joined.Invites) != 0 {
+		t.Fatalf("expected bob to join on accept, got members %v, invites %v", joined.Members, joined.Invites)
+	}
+
+	// carol already takes alice's messages, so she is added directly
+	_, _ = s.contacts.Accept(context.Background(), "carol@example.com", "alice@example.com")
+	if _, err := s.AddMember(claimsContext("alice@example.com"), &v1.AddMemberRequest{GroupId: group.GetGroupId(), Email: "carol@example.com"}); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+	if !joined.HasMember("carol@example.com") {
+		t.Fatalf("expected carol to be added directly, got %v", joined.Members)
+	}
+}
+
+func TestGroupInvite_Declined(t *testing.T) {
+	group := &data.Group{ID: bson.NewObjectID(), OwnerEmail: "alice@example.com", Members: []string{"alice@example.com"}}
+	s := &Server{
+		blocks:   &fakeBlocks{},
+		contacts: &fakeContacts{},
+		users:    existingUsers{},
+		groups:   &fakeGroups{group: group},
+		msgs:     &fakeGroupMsgs{},
+		convs:    &recordingConvs{ConversationsStore: &fakeConvs{}},
+	}
+	if _, err := s.AddMember(claimsContext("alice@example.com"), &v1.AddMemberRequest{GroupId: group.ID.Hex(), Email: "bob@example.com"}); err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+	if !group.HasInvite("bob@example.com") {
+		t.Fatalf("expected bob to be invited, got %v", group.Invites)
+	}
+
+	_, err := s.RespondToMessageRequest(claimsContext("bob@example.com"), &v1.RespondToMessageRequestRequest{FromEmail: "alice@example.com", Action: v1.MessageRequestAction_MESSAGE_REQUEST_ACTION_DECLINE})
+	if err != nil {
+		t.Fatalf("RespondToMessageRequest failed: %v", err)
+	}
+	if group.HasMember("bob@example.com") || group.HasInvite("bob@example.com") {
+		t.Fatalf("expected the invite to be dropped, got members %v, invites %v", group.Members, group.Invites)
+	}
+}
+
+func TestAcceptRequest_AllPages(t *testing.T) {
+	ctx := context.Background()
+	msgs := &fakeGroupMsgs{}
+	contacts := &fakeContacts{}
+	for range pendingPageSize + 2 {
+		m, _ := msgs.InsertMessage(ctx, &data.Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "hi", Pending: true})
+		_ = contacts.RecordRequest(ctx, "bob@example.com", "alice@example.com", m)
+	}
+	hub := NewConnectionHub()
+	bob := &fakeSender{}
+	hub.Register("bob@example.com", bob)
+	convs := &recordingConvs{ConversationsStore: &fakeConvs{}}
+	s := &Server{hub: hub, contacts: contacts, groups: &fakeGroups{}, msgs: msgs, convs: convs}
+
+	// every cleared message counts towards bob's chat, not just the first page
+	delivered, err := s.acceptRequest(ctx, "bob@example.com", "alice@example.com")
+	if err != nil {
+		t.Fatalf("acceptRequest failed: %v", err)
+	}
+	if delivered != len(msgs.inserted) || len(convs.participants) != len(msgs.inserted) {
+		t.Fatalf("expected %d messages recorded, got %d delivered and %d recorded", len(msgs.inserted), delivered, len(convs.participants))
+	}
+	newest := msgs.inserted[len(msgs.inserted)-1]
+	if bob.last.GetMessage().GetMsgId() != newest.ID.Hex() {
+		t.Fatalf("expected the newest message pushed last, got %v", bob.last)
+	}
+}
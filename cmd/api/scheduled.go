@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"html"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxScheduleAhead is how far in the future a message may be scheduled
+	maxScheduleAhead = 365 * 24 * time.Hour
+	// maxScheduledPerUser caps ListScheduled
+	maxScheduledPerUser = 500
+	// dispatchInterval is how often the dispatcher looks for due messages
+	dispatchInterval = time.Second
+	// dispatchLease is how long a claimed message is reserved for one send
+	// attempt before another dispatcher (or this one, after a restart) retries
+	dispatchLease = time.Minute
+)
+
+// ScheduleMessage stores a 1-on-1 or group message to be sent at send_at.
+// Recipients and group membership are checked now and again when it is sent.
+func (s *Server) ScheduleMessage(ctx context.Context, req *v1.ScheduleMessageRequest) (*v1.ScheduleMessageResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if req.GetContent() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	if err := checkContent(req.GetContent()); err != nil {
+		return nil, err
+	}
+	sendAt, err := scheduleTime(req.GetSendAt(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	sm := &data.ScheduledMessage{
+		FromEmail: claims.Email,
+		Content:   html.EscapeString(req.GetContent()),
+		SendAt:    sendAt,
+	}
+	switch {
+	case req.GetGroupId() != "":
+		group, err := s.memberGroup(ctx, req.GetGroupId(), claims.Email)
+		if err != nil {
+			return nil, err
+		}
+		sm.GroupID = group.ID
+	case req.GetToEmail() != "":
+		exists, err := s.users.UserExists(ctx, req.GetToEmail())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to verify recipient: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "recipient not found")
+		}
+		sm.ToEmail = normalize.Email(req.GetToEmail())
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "to_email or group_id is required")
+	}
+
+	created, err := s.scheduled.CreateScheduled(ctx, sm)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule message: %v", err)
+	}
+	return &v1.ScheduleMessageResponse{Scheduled: scheduledToProto(created)}, nil
+}
+
+// ListScheduled returns the caller's pending scheduled messages, soonest first.
+func (s *Server) ListScheduled(ctx context.Context, req *v1.ListScheduledRequest) (*v1.ListScheduledResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	scheduled, err := s.scheduled.ListScheduled(ctx, claims.Email, maxScheduledPerUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled messages: %v", err)
+	}
+	resp := &v1.ListScheduledResponse{Scheduled: make([]*v1.ScheduledMessage, 0, len(scheduled))}
+	for _, sm := range scheduled {
+		resp.Scheduled = append(resp.Scheduled, scheduledToProto(sm))
+	}
+	return resp, nil
+}
+
+// CancelScheduled cancels one of the caller's pending scheduled messages. A
+// message that is already being sent can't be cancelled.
+func (s *Server) CancelScheduled(ctx context.Context, req *v1.CancelScheduledRequest) (*v1.CancelScheduledResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	id, err := bson.ObjectIDFromHex(req.GetScheduledId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scheduled_id")
+	}
+
+	if err := s.scheduled.CancelScheduled(ctx, id, claims.Email); err != nil {
+		if errors.Is(err, data.ErrScheduledNotFound) {
+			return nil, status.Errorf(codes.NotFound, "scheduled message not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled message: %v", err)
+	}
+	return &v1.CancelScheduledResponse{ScheduledId: id.Hex()}, nil
+}
+
+// scheduleTime validates a requested send time: it must be set, in the future
+// and no more than maxScheduleAhead away.
+func scheduleTime(ts *timestamppb.Timestamp, now time.Time) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "send_at is required")
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid send_at: %v", err)
+	}
+	sendAt := ts.AsTime()
+	if !sendAt.After(now) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "send_at must be in the future")
+	}
+	if sendAt.Sub(now) > maxScheduleAhead {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "send_at is too far in the future")
+	}
+	return sendAt, nil
+}
+
+// dispatchScheduled sends scheduled messages as they fall due until ctx is
+// cancelled. Pending messages live in the database, so anything that came due
+// while the server was down is sent on the first tick after a restart.
+func (s *Server) dispatchScheduled(ctx context.Context) {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for ctx.Err() == nil {
+			sm, err := s.scheduled.ClaimDue(ctx, time.Now(), dispatchLease)
+			if err != nil {
+				log.Printf("failed to claim scheduled messages: %v", err)
+				break
+			}
+			if sm == nil {
+				break
+			}
+			s.dispatch(ctx, sm)
+		}
+	}
+}
+
+// dispatch stores and delivers one claimed scheduled message. The message
+// gets a ClientMsgID derived from the scheduled ID, so a retry after a crash
+// between saving and marking it sent does not store it twice. Transient
+// errors leave the claim to expire and be retried; a message that can never
+// be sent (the recipient or group is gone, or the sender left) is marked failed.
+func (s *Server) dispatch(ctx context.Context, sm *data.ScheduledMessage) {
+	msg := &data.Message{
+		FromEmail:   sm.FromEmail,
+		ToEmail:     sm.ToEmail,
+		GroupID:     sm.GroupID,
+		Content:     sm.Content,
+		SentAt:      time.Now(),
+		ClientMsgID: "scheduled:" + sm.ID.Hex(),
+	}
+
+	participants, reason, err := s.scheduledParticipants(ctx, sm)
+	if err != nil {
+		log.Printf("failed to resolve participants of scheduled %s: %v", sm.ID.Hex(), err)
+		return
+	}
+	if reason == "" && !sm.GroupID.IsZero() {
+		// Members with a block with the sender never see the message
+		participants, msg.HiddenFor, err = s.splitBlocked(ctx, sm.FromEmail, participants)
+		if err != nil {
+			log.Printf("failed to check blocks for scheduled %s: %v", sm.ID.Hex(), err)
+			return
+		}
+	}
+	if reason == "" && sm.GroupID.IsZero() {
+		// Contacts and the chat's expiry setting at send time apply, as for live sends
+		msg.Pending, err = s.messageGate(ctx, sm.FromEmail, sm.ToEmail)
+		if err != nil {
+			log.Printf("failed to check contacts for scheduled %s: %v", sm.ID.Hex(), err)
+			return
+		}
+		if msg.Pending {
+			participants = []string{sm.FromEmail}
+		}
+		msg.ExpiresAt, err = s.messageExpiry(ctx, sm.FromEmail, sm.ToEmail, msg.SentAt)
+		if err != nil {
+			log.Printf("failed to load expiry for scheduled %s: %v", sm.ID.Hex(), err)
+			return
+		}
+	}
+	if reason != "" {
+		if err := s.scheduled.MarkFailed(ctx, sm.ID, reason); err != nil {
+			log.Printf("failed to mark scheduled %s failed: %v", sm.ID.Hex(), err)
+		}
+		return
+	}
+
+	saved, created, err := s.saveMessage(ctx, msg, participants)
+	if err != nil {
+		log.Printf("failed to save scheduled %s: %v", sm.ID.Hex(), err)
+		return
+	}
+	if err := s.scheduled.MarkSent(ctx, sm.ID, saved.ID); err != nil {
+		log.Printf("failed to mark scheduled %s sent: %v", sm.ID.Hex(), err)
+	}
+	if !created {
+		return
+	}
+	if saved.Pending {
+		s.recordRequest(ctx, saved)
+	}
+
+	// The sender's own devices get the message too, since no stream acked it
+	if s.hub != nil {
+		if err := s.hub.SendToUsers(participants, streamResponse(saved)); err != nil {
+			log.Printf("delivery of scheduled %s incomplete: %v", sm.ID.Hex(), err)
+		}
+	}
+}
+
+// scheduledParticipants resolves who receives sm at send time. A non-empty
+// reason means the message can no longer be sent.
+func (s *Server) scheduledParticipants(ctx context.Context, sm *data.ScheduledMessage) ([]string, string, error) {
+	if sm.GroupID.IsZero() {
+		exists, err := s.users.UserExists(ctx, sm.ToEmail)
+		if err != nil {
+			return nil, "", err
+		}
+		if !exists {
+			return nil, "recipient not found", nil
+		}
+		blocked, err := s.blocked(ctx, sm.FromEmail, sm.ToEmail)
+		if err != nil {
+			return nil, "", err
+		}
+		if blocked {
+			return nil, "messages between sender and recipient are blocked", nil
+		}
+		return []string{sm.FromEmail, sm.ToEmail}, "", nil
+	}
+
+	group, err := s.groups.GetGroup(ctx, sm.GroupID)
+	if err != nil {
+		if errors.Is(err, data.ErrGroupNotFound) {
+			return nil, "group not found", nil
+		}
+		return nil, "", err
+	}
+	if !group.HasMember(sm.FromEmail) {
+		return nil, "sender is no longer a member of the group", nil
+	}
+	return group.Members, "", nil
+}
+
+// scheduledToProto converts a stored scheduled message into its API representation.
+func scheduledToProto(sm *data.ScheduledMessage) *v1.ScheduledMessage {
+	return &v1.ScheduledMessage{
+		ScheduledId: sm.ID.Hex(),
+		ToEmail:     sm.ToEmail,
+		GroupId:     groupIDHex(sm.GroupID),
+		Content:     sm.Content,
+		SendAt:      timestamppb.New(sm.SendAt),
+		CreatedAt:   timestamppb.New(sm.CreatedAt),
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduleTime(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name string
+		ts   *timestamppb.Timestamp
+		ok   bool
+	}{
+		{"missing", nil, false},
+		{"past", timestamppb.New(now.Add(-time.Minute)), false},
+		{"now", timestamppb.New(now), false},
+		{"soon", timestamppb.New(now.Add(time.Minute)), true},
+		{"too far", timestamppb.New(now.Add(maxScheduleAhead + time.Hour)), false},
+	}
+	for _, tc := range cases {
+		got, err := scheduleTime(tc.ts, now)
+		if tc.ok {
+			if err != nil || !got.Equal(tc.ts.AsTime()) {
+				t.Errorf("%s: got %v, %v", tc.name, got, err)
+			}
+			continue
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", tc.name, err)
+		}
+	}
+}
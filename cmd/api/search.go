@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultSearchPageSize is used when SearchMessages is called without a page_size
+	defaultSearchPageSize = 20
+	// maxSearchPageSize matches the page_size bound declared in the proto
+	maxSearchPageSize = 100
+	// snippetRunes is the length of the content excerpt returned per result
+	snippetRunes = 160
+	// minStemRunes is how long a content word must be to match a longer query
+	// word it prefixes ("decision" for "decisions")
+	minStemRunes = 4
+)
+
+// SearchMessages runs a full-text search over the caller's 1-on-1 conversations
+// and current groups, optionally narrowed to one conversation and a date range
+func (s *Server) SearchMessages(ctx context.Context, req *v1.SearchMessagesRequest) (*v1.SearchMessagesResponse, error) {
+	claims, ok := getClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+	if req.GetWithEmail() != "" && req.GetGroupId() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "with_email and group_id are mutually exclusive")
+	}
+	pageSize, err := resolvePageSize(req.GetPageSize(), defaultSearchPageSize, maxSearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra result to learn whether another page follows
+	q := data.MessageSearch{Query: req.GetQuery(), Viewer: claims.Email, Limit: int64(pageSize) + 1}
+	switch {
+	case req.GetGroupId() != "":
+		group, err := s.memberGroup(ctx, req.GetGroupId(), claims.Email)
+		if err != nil {
+			return nil, err
+		}
+		q.GroupsOnly = true
+		q.GroupIDs = append(q.GroupIDs, group.ID)
+	case req.GetWithEmail() != "":
+		q.PartnerEmail = normalize.Email(req.GetWithEmail())
+	default:
+		ids, err := s.memberGroupIDs(ctx, claims.Email)
+		if err != nil {
+			return nil, err
+		}
+		q.GroupIDs = ids
+	}
+	if req.GetSentAfter() != nil {
+		q.SentAfter = req.GetSentAfter().AsTime()
+	}
+	if req.GetSentBefore() != nil {
+		q.SentBefore = req.GetSentBefore().AsTime()
+	}
+
+	if req.GetCursor() != "" {
+		// The cursor only supplies a position, but a message from someone else's
+		// conversation must not be usable to probe it
+		msg, err := s.loadMessage(ctx, req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+		emails, err := s.participants(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(emails, claims.Email) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		q.Cursor = data.CursorAt(msg, false)
+	}
+
+	msgs, err := s.msgs.SearchMessages(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search messages: %v", err)
+	}
+
+	resp := &v1.SearchMessagesResponse{}
+	if len(msgs) > pageSize {
+		msgs = msgs[:pageSize]
+		resp.NextCursor = msgs[len(msgs)-1].ID.Hex()
+	}
+	terms := searchTerms(req.GetQuery())
+	for _, m := range msgs {
+		snippet, highlights := highlight(m.Content, terms)
+		resp.Results = append(resp.Results, &v1.SearchResult{
+			MsgId:      m.ID.Hex(),
+			FromEmail:  m.FromEmail,
+			ToEmail:    m.ToEmail,
+			GroupId:    groupIDHex(m.GroupID),
+			SentAt:     timestamppb.New(m.SentAt),
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+	return resp, nil
+}
+
+// searchTerms extracts the lowercased words to highlight from a text query,
+// skipping -excluded words and single characters
+func searchTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(strings.ReplaceAll(query, `"`, " ")) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		term := strings.ToLower(strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}))
+		if len([]rune(term)) < 2 || slices.Contains(terms, term) {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// termMatches approximates the text index's stemming: a word matches a term it
+// starts with, or a longer term it is a reasonably long prefix of
+func termMatches(word string, terms []string) bool {
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) ||
+			len([]rune(word)) >= minStemRunes && strings.HasPrefix(term, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// highlight returns an excerpt of content around the first matching word along
+// with the spans of every matching word inside it, in code points
+func highlight(content string, terms []string) (string, []*v1.Highlight) {
+	runes := []rune(content)
+
+	// Find every word (run of letters/digits) matching a term
+	type span struct{ start, end int }
+	var spans []span
+	for i := 0; i < len(runes); {
+		if !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+			j++
+		}
+		if termMatches(strings.ToLower(string(runes[i:j])), terms) {
+			spans = append(spans, span{i, j})
+		}
+		i = j
+	}
+
+	// Window the content so the first match sits near the start of the snippet
+	start := 0
+	if len(spans) > 0 {
+		start = max(0, spans[0].start-snippetRunes/4)
+	}
+	end := min(len(runes), start+snippetRunes)
+	start = max(0, end-snippetRunes)
+
+	var b strings.Builder
+	offset := -start
+	if start > 0 {
+		b.WriteString("…")
+		offset++
+	}
+	b.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	var highlights []*v1.Highlight
+	for _, sp := range spans {
+		if sp.start >= start && sp.end <= end {
+			highlights = append(highlights, &v1.Highlight{Start: int32(sp.start + offset), End: int32(sp.end + offset)})
+		}
+	}
+	return b.String(), highlights
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchTerms(t *testing.T) {
+	got := searchTerms(`"Launch date" -draft decisions, a Launch`)
+	want := []string{"launch", "date", "decisions"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	snippet, hl := highlight("We made the decision: launching Friday.", []string{"decisions", "launch"})
+	if snippet != "We made the decision: launching Friday." {
+		t.Fatalf("short content should be returned whole, got %q", snippet)
+	}
+	runes := []rune(snippet)
+	var words []string
+	for _, h := range hl {
+		words = append(words, string(runes[h.Start:h.End]))
+	}
+	if !slices.Equal(words, []string{"decision", "launching"}) {
+		t.Fatalf("unexpected highlights: %v", words)
+	}
+
+	// long content is windowed around the first match with ellipses
+	long := strings.Repeat("filler ", 100) + "décision " + strings.Repeat("filler ", 100)
+	snippet, hl = highlight(long, []string{"décision"})
+	runes = []rune(snippet)
+	if len(runes) != snippetRunes+2 || !strings.HasPrefix(snippet, "…") || !strings.HasSuffix(snippet, "…") {
+		t.Fatalf("unexpected snippet window: %q", snippet)
+	}
+	if len(hl) != 1 || string(runes[hl[0].Start:hl[0].End]) != "décision" {
+		t.Fatalf("unexpected highlight in window: %v", hl)
+	}
+}
+
+func TestSearchMessages_PageSizeBounds(t *testing.T) {
+	s := &Server{}
+	ctx := context.WithValue(context.Background(), authContextKey{}, &auth.Claims{Email: "alice@example.com"})
+
+	for _, size := range []int32{-1, maxSearchPageSize + 1} {
+		_, err := s.SearchMessages(ctx, &v1.SearchMessagesRequest{Query: "launch", PageSize: size})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("page_size %d: got %v, want InvalidArgument", size, err)
+		}
+	}
+}
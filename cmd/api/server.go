@@ -5,8 +5,11 @@ import (
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/blob"
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/middleware"
 	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"google.golang.org/grpc"
 )
 
@@ -16,27 +19,166 @@ type UsersStore interface {
 	CreateUser(ctx context.Context, email, hashedPassword string) (*data.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*data.User, error)
 	UserExists(ctx context.Context, email string) (bool, error)
+	SetLastSeen(ctx context.Context, email string, at time.Time) error
+	SetLastSeenVisibility(ctx context.Context, email, visibility string) error
+	GetUsersByEmails(ctx context.Context, emails []string) ([]*data.User, error)
+	UpdateProfile(ctx context.Context, email string, p data.ProfileUpdate) (*data.User, error)
+	AvatarInUse(ctx context.Context, attachmentID bson.ObjectID) (bool, error)
+	SetDiscoverable(ctx context.Context, email string, discoverable bool) error
+	SearchUsers(ctx context.Context, q data.UserSearch) ([]*data.User, error)
 }
 
 // MessagesStore is the subset of data.MessagesStore used by the API handlers.
 type MessagesStore interface {
 	SaveMessage(ctx context.Context, fromEmail, toEmail, content string, sentAt time.Time) (*data.Message, error)
-	GetRecentChats(ctx context.Context, userEmail string, limit int64) ([]*data.ChatPartner, error)
-	GetMessageHistory(ctx context.Context, user1, user2 string, limit int64) ([]*data.Message, error)
+	InsertMessage(ctx context.Context, msg *data.Message) (*data.Message, error)
+	GetMessage(ctx context.Context, id bson.ObjectID) (*data.Message, error)
+	GetMessageByClientID(ctx context.Context, fromEmail, clientMsgID string) (*data.Message, error)
+	EditMessage(ctx context.Context, id bson.ObjectID, fromEmail, content string, editedAt time.Time) (*data.Message, error)
+	DeleteMessageForEveryone(ctx context.Context, id bson.ObjectID, fromEmail string, deletedAt time.Time) (*data.Message, error)
+	HideMessage(ctx context.Context, id bson.ObjectID, email string) error
+	MarkDelivered(ctx context.Context, id bson.ObjectID, email string) (*data.Message, error)
+	GetUndelivered(ctx context.Context, email string, groups []*data.Group, limit int64) ([]*data.Message, error)
+	GetInboxSince(ctx context.Context, email string, groupIDs []bson.ObjectID, after bson.ObjectID, limit int64) ([]*data.Message, error)
+	GetPending(ctx context.Context, fromEmail, toEmail string, after *data.HistoryCursor, limit int64) ([]*data.Message, error)
+	ClearPending(ctx context.Context, fromEmail, toEmail string) error
+	React(ctx context.Context, id bson.ObjectID, email, emoji string, maxPerUser int) (*data.Message, error)
+	Unreact(ctx context.Context, id bson.ObjectID, email, emoji string) (*data.Message, error)
+	PinMessage(ctx context.Context, id bson.ObjectID, email string, pinnedAt time.Time, maxPins int) (*data.Message, error)
+	UnpinMessage(ctx context.Context, id bson.ObjectID) (*data.Message, error)
+	GetPinned(ctx context.Context, viewer, partner string, limit int64) ([]*data.Message, error)
+	GetGroupPinned(ctx context.Context, groupID bson.ObjectID, viewer string, limit int64) ([]*data.Message, error)
+	CountReceived(ctx context.Context, owner, partner string, after, upTo time.Time) (int64, error)
+	CountGroupReceived(ctx context.Context, owner string, groupID bson.ObjectID, after, upTo time.Time) (int64, error)
+	GetMessageHistory(ctx context.Context, user1, user2 string, cursor *data.HistoryCursor, limit int64) ([]*data.Message, error)
+	GetGroupHistory(ctx context.Context, groupID bson.ObjectID, viewer string, cursor *data.HistoryCursor, limit int64) ([]*data.Message, error)
+	SearchMessages(ctx context.Context, q data.MessageSearch) ([]*data.Message, error)
+	GetThread(ctx context.Context, rootID bson.ObjectID, viewer string, limit int64) ([]*data.Message, error)
+	GetMessagesWithAttachment(ctx context.Context, attachmentID bson.ObjectID, limit int64) ([]*data.Message, error)
+	ConversationSummaries(ctx context.Context, fn func(*data.Conversation) error) error
+}
+
+// GroupsStore is the subset of data.GroupsStore used by the API handlers.
+type GroupsStore interface {
+	CreateGroup(ctx context.Context, name, ownerEmail string, memberEmails []string) (*data.Group, error)
+	GetGroup(ctx context.Context, id bson.ObjectID) (*data.Group, error)
+	AddMember(ctx context.Context, id bson.ObjectID, email string) (*data.Group, error)
+	RemoveMember(ctx context.Context, id bson.ObjectID, email string) (*data.Group, error)
+	Invite(ctx context.Context, id bson.ObjectID, email, invitedBy string) (*data.Group, bool, error)
+	AcceptInvites(ctx context.Context, email, invitedBy string) (int64, error)
+	DropInvites(ctx context.Context, email, invitedBy string) error
+	SetOwner(ctx context.Context, id bson.ObjectID, email string) (*data.Group, error)
+	ListGroupsForUser(ctx context.Context, email string) ([]*data.Group, error)
+}
+
+// ConversationsStore is the subset of data.ConversationsStore used by the API handlers.
+type ConversationsStore interface {
+	MarkRead(ctx context.Context, owner, partner string, readAt time.Time) (time.Time, error)
+	MarkGroupRead(ctx context.Context, owner string, groupID bson.ObjectID, readAt time.Time) (time.Time, error)
+	ReduceUnread(ctx context.Context, owner, partner string, n int64) error
+	ReduceGroupUnread(ctx context.Context, owner string, groupID bson.ObjectID, n int64) error
+	RecordMessage(ctx context.Context, msg *data.Message, participants []string) error
+	UpdateLastMessage(ctx context.Context, msg *data.Message) (bool, error)
+	ReplaceLastMessage(ctx context.Context, owner string, hiddenID bson.ObjectID, latest *data.Message) (bool, error)
+	Backfill(ctx context.Context, summaries []*data.Conversation) (int64, error)
+	SetExpiry(ctx context.Context, a, b string, seconds int64) error
+	GetConversation(ctx context.Context, owner, partner string) (*data.Conversation, error)
+	GetGroupConversation(ctx context.Context, owner string, groupID bson.ObjectID) (*data.Conversation, error)
+	ListConversations(ctx context.Context, owner string, hidden []string, groupIDs []bson.ObjectID, limit int64) ([]*data.Conversation, error)
+	DeleteGroupConversation(ctx context.Context, owner string, groupID bson.ObjectID) error
+	GetReadAt(ctx context.Context, owner, partner string) (time.Time, error)
+	GetGroupReadAt(ctx context.Context, groupID bson.ObjectID) (map[string]time.Time, error)
+}
+
+// AttachmentsStore is the subset of data.AttachmentsStore used by the API handlers.
+type AttachmentsStore interface {
+	CreateAttachment(ctx context.Context, att *data.Attachment) error
+	GetAttachment(ctx context.Context, id bson.ObjectID) (*data.Attachment, error)
+}
+
+// ScheduledStore is the subset of data.ScheduledStore used by the API handlers
+// and the scheduled message dispatcher.
+type ScheduledStore interface {
+	CreateScheduled(ctx context.Context, sm *data.ScheduledMessage) (*data.ScheduledMessage, error)
+	ListScheduled(ctx context.Context, owner string, limit int64) ([]*data.ScheduledMessage, error)
+	CancelScheduled(ctx context.Context, id bson.ObjectID, owner string) error
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*data.ScheduledMessage, error)
+	MarkSent(ctx context.Context, id, msgID bson.ObjectID) error
+	MarkFailed(ctx context.Context, id bson.ObjectID, reason string) error
+}
+
+// BlocksStore is the subset of data.BlocksStore used by the API handlers.
+type BlocksStore interface {
+	Block(ctx context.Context, blocker, blocked string) (*data.Block, error)
+	Unblock(ctx context.Context, blocker, blocked string) error
+	ListBlocked(ctx context.Context, blocker string) ([]*data.Block, error)
+	BlockBetween(ctx context.Context, a, b string) (*data.Block, error)
+	BlockedWith(ctx context.Context, email string) ([]string, error)
+	BlockedAmong(ctx context.Context, email string, emails []string) ([]string, error)
+}
+
+// ContactsStore is the subset of data.ContactsStore used by the API handlers.
+type ContactsStore interface {
+	GetContact(ctx context.Context, owner, contact string) (*data.Contact, error)
+	Accept(ctx context.Context, owner, contact string) (string, error)
+	RecordRequest(ctx context.Context, owner, contact string, msg *data.Message) error
+	Decline(ctx context.Context, owner, contact string) error
+	ListRequests(ctx context.Context, owner string, limit int64) ([]*data.Contact, error)
+}
+
+// RefreshTokensStore is the subset of data.RefreshTokensStore used by the API handlers.
+type RefreshTokensStore interface {
+	CreateToken(ctx context.Context, t *data.RefreshToken) (*data.RefreshToken, error)
+	Rotate(ctx context.Context, hash string, now time.Time) (*data.RefreshToken, error)
 }
 
 type Server struct {
 	v1.UnimplementedChatServiceServer
 
-	users UsersStore
-	msgs  MessagesStore
-	auth  *auth.JWTManager
-	hub   *ConnectionHub
+	users     UsersStore
+	msgs      MessagesStore
+	groups    GroupsStore
+	convs     ConversationsStore
+	atts      AttachmentsStore
+	scheduled ScheduledStore
+	blocks    BlocksStore
+	contacts  ContactsStore
+	tokens    RefreshTokensStore
+	blobs     blob.Store
+	auth      *auth.JWTManager
+	hub       *ConnectionHub
+
+	// maxAttachmentBytes caps the size of a single upload
+	maxAttachmentBytes int64
+	// maxPins caps the pinned messages per conversation
+	maxPins int
+	// searchLimiter rate limits SearchUsers per caller; nil disables the limit
+	searchLimiter *middleware.LimiterStore
+	// refreshTTL is how long a refresh token stays usable
+	refreshTTL time.Duration
 }
 
 // newServer returns a ready-to-use Server wired with stores and auth manager.
-func newServer(users UsersStore, msgs MessagesStore, authMgr *auth.JWTManager, hub *ConnectionHub) *Server {
-	return &Server{users: users, msgs: msgs, auth: authMgr, hub: hub}
+// Upload size defaults to defaultMaxAttachmentBytes, the pin limit to
+// defaultMaxPins and the refresh token lifetime to defaultRefreshTokenTTL.
+func newServer(users UsersStore, msgs MessagesStore, groups GroupsStore, convs ConversationsStore, atts AttachmentsStore, scheduled ScheduledStore, blocks BlocksStore, contacts ContactsStore, tokens RefreshTokensStore, blobs blob.Store, authMgr *auth.JWTManager, hub *ConnectionHub) *Server {
+	return &Server{
+		users:              users,
+		msgs:               msgs,
+		groups:             groups,
+		convs:              convs,
+		atts:               atts,
+		scheduled:          scheduled,
+		blocks:             blocks,
+		contacts:           contacts,
+		tokens:             tokens,
+		blobs:              blobs,
+		auth:               authMgr,
+		hub:                hub,
+		maxAttachmentBytes: defaultMaxAttachmentBytes,
+		maxPins:            defaultMaxPins,
+		refreshTTL:         defaultRefreshTokenTTL,
+	}
 }
 
 // registerService registers the ChatService on the given gRPC server.
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quoteMaxRunes caps the quoted text snapshot stored on replies.
+const quoteMaxRunes = 200
+
+// threadLimit caps how many messages GetThread returns.
+const threadLimit = 500
+
+// GetThread streams the root of the thread msg_id belongs to, followed by
+// every reply in it
+func (s *Server) GetThread(req *v1.GetThreadRequest, stream v1.ChatService_GetThreadServer) error {
+	claims, ok := getClaimsFromContext(stream.Context())
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing auth claims")
+	}
+
+	msg, err := s.loadMessage(stream.Context(), req.GetMsgId())
+	if err != nil {
+		return err
+	}
+	emails, err := s.participants(stream.Context(), msg)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to resolve participants: %v", err)
+	}
+	if !slices.Contains(emails, claims.Email) {
+		return status.Errorf(codes.PermissionDenied, "not a participant of this conversation")
+	}
+
+	msgs, err := s.msgs.GetThread(stream.Context(), msg.ThreadRoot(), claims.Email, threadLimit)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get thread: %v", err)
+	}
+	for _, m := range msgs {
+		if err := stream.Send(&v1.GetThreadResponse{Message: streamResponse(m).GetMessage()}); err != nil {
+			return status.Errorf(codes.Internal, "failed to send message: %v", err)
+		}
+	}
+	return nil
+}
+
+// replyTarget resolves the message a new message replies to. inConversation
+// must report whether a message belongs to the conversation being posted to;
+// replies across conversations are rejected. An empty ID means no reply.
+func (s *Server) replyTarget(ctx context.Context, replyToMsgID string, inConversation func(*data.Message) bool) (*data.QuotedMessage, bson.ObjectID, error) {
+	if replyToMsgID == "" {
+		return nil, bson.ObjectID{}, nil
+	}
+
+	target, err := s.loadMessage(ctx, replyToMsgID)
+	if err != nil {
+		return nil, bson.ObjectID{}, err
+	}
+	if !inConversation(target) {
+		return nil, bson.ObjectID{}, status.Errorf(codes.InvalidArgument, "reply_to_msg_id is not in this conversation")
+	}
+	if !target.DeletedAt.IsZero() {
+		return nil, bson.ObjectID{}, status.Errorf(codes.FailedPrecondition, "cannot reply to a deleted message")
+	}
+
+	quote := &data.QuotedMessage{
+		MsgID:     target.ID,
+		FromEmail: target.FromEmail,
+		Content:   truncateRunes(target.Content, quoteMaxRunes),
+	}
+	return quote, target.ThreadRoot(), nil
+}
+
+// truncateRunes shortens s to at most n runes.
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+// quoteToProto converts a stored quote into its API representation.
+func quoteToProto(q *data.QuotedMessage) *v1.QuotedMessage {
+	if q == nil {
+		return nil
+	}
+	return &v1.QuotedMessage{
+		MsgId:     q.MsgID.Hex(),
+		FromEmail: q.FromEmail,
+		Content:   q.Content,
+	}
+}
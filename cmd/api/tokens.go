@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/auth"
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/data"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultRefreshTokenTTL is how long a refresh token stays usable unless
+// REFRESH_TOKEN_TTL is set. Every refresh starts a new period.
+const defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// RefreshToken rotates a refresh token: the presented token is used up and a
+// new access token and refresh token are issued in its place
+func (s *Server) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	old, err := s.tokens.Rotate(ctx, auth.HashRefreshToken(req.GetRefreshToken()), time.Now())
+	if err != nil {
+		if errors.Is(err, data.ErrRefreshTokenReused) {
+			log.Printf("refresh token reused; revoked its token family")
+			return nil, status.Errorf(codes.Unauthenticated, "refresh token already used; sign in again")
+		}
+		if errors.Is(err, data.ErrRefreshTokenInvalid) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rotate refresh token: %v", err)
+	}
+
+	user, err := s.users.GetUserByEmail(ctx, old.Email)
+	if err != nil {
+		if errors.Is(err, data.ErrUserNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load user: %v", err)
+	}
+
+	tokens, err := s.issueTokens(ctx, user, old.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RefreshTokenResponse{
+		Token:                 tokens.access,
+		UserId:                user.ID.Hex(),
+		ExpiresAt:             timestamppb.New(tokens.accessExpiresAt),
+		RefreshToken:          tokens.refresh,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.refreshExpiresAt),
+	}, nil
+}
+
+// issuedTokens is an access token and the refresh token to renew it with.
+type issuedTokens struct {
+	access           string
+	accessExpiresAt  time.Time
+	refresh          string
+	refreshExpiresAt time.Time
+}
+
+// issueTokens signs an access token for user and stores a new refresh token
+// in the given family; a zero family starts a new one, as on login.
+func (s *Server) issueTokens(ctx context.Context, user *data.User, family bson.ObjectID) (*issuedTokens, error) {
+	access, accessExpiresAt, err := s.auth.GenerateToken(user.ID, user.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+
+	refresh, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
+	}
+	now := time.Now()
+	stored, err := s.tokens.CreateToken(ctx, &data.RefreshToken{
+		FamilyID:  family,
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: hash,
+		ExpiresAt: now.Add(s.refreshTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store refresh token: %v", err)
+	}
+
+	return &issuedTokens{
+		access:           access,
+		accessExpiresAt:  accessExpiresAt,
+		refresh:          refresh,
+		refreshExpiresAt: stored.ExpiresAt,
+	}, nil
+}
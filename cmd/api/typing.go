@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+	"golang.org/x/time/rate"
+)
+
+// Typing events allowed per stream: a short burst, then one every typingInterval.
+// Clients should expire indicators on their own in case a stop event is dropped.
+const (
+	typingInterval = 500 * time.Millisecond
+	typingBurst    = 4
+)
+
+// newTypingLimiter returns the per-stream throttle for typing events.
+func newTypingLimiter() *rate.Limiter {
+	return rate.NewLimiter(rate.Every(typingInterval), typingBurst)
+}
+
+// relayTyping forwards a typing indicator from fromEmail to the other side of
+// the conversation. Typing events are never persisted and failures are only
+// logged, since a lost indicator is harmless.
+func (s *Server) relayTyping(ctx context.Context, fromEmail string, ev *v1.TypingEvent, started bool) {
+	if s.hub == nil {
+		return
+	}
+
+	indicator := &v1.TypingIndicator{FromEmail: fromEmail, GroupId: ev.GetGroupId()}
+	resp := &v1.ChatStreamResponse{Event: &v1.ChatStreamResponse_TypingStopped{TypingStopped: indicator}}
+	if started {
+		resp = &v1.ChatStreamResponse{Event: &v1.ChatStreamResponse_TypingStarted{TypingStarted: indicator}}
+	}
+
+	var recipients []string
+	if ev.GetGroupId() != "" {
+		group, err := s.memberGroup(ctx, ev.GetGroupId(), fromEmail)
+		if err != nil {
+			log.Printf("dropping typing event from %s: %v", fromEmail, err)
+			return
+		}
+		members, _, err := s.splitBlocked(ctx, fromEmail, group.Members)
+		if err != nil {
+			log.Printf("dropping typing event from %s: %v", fromEmail, err)
+			return
+		}
+		for _, m := range members {
+			if m != fromEmail {
+				recipients = append(recipients, m)
+			}
+		}
+	} else {
+		toEmail := normalize.Email(ev.GetToEmail())
+		blocked, err := s.blocked(ctx, fromEmail, toEmail)
+		if err != nil {
+			log.Printf("dropping typing event from %s: %v", fromEmail, err)
+			return
+		}
+		if blocked {
+			return
+		}
+		// Strangers can't reach someone's stream until they accept a message request
+		accepted, err := s.acceptsFrom(ctx, toEmail, fromEmail)
+		if err != nil {
+			log.Printf("dropping typing event from %s: %v", fromEmail, err)
+			return
+		}
+		if !accepted {
+			return
+		}
+		recipients = []string{toEmail}
+	}
+
+	if err := s.hub.SendToUsers(recipients, resp); err != nil {
+		log.Printf("typing relay from %s incomplete: %v", fromEmail, err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/PaulBabatuyi/reaTimeChat-gRPC/proto/chat/v1"
+)
+
+func TestRelayTyping_DirectConversation(t *testing.T) {
+	hub := NewConnectionHub()
+	bob := &fakeSender{}
+	hub.Register("bob@example.com", bob)
+
+	contacts := &fakeContacts{}
+	_, _ = contacts.Accept(context.Background(), "bob@example.com", "alice@example.com")
+	s := &Server{hub: hub, blocks: &fakeBlocks{}, contacts: contacts}
+	s.relayTyping(context.Background(), "alice@example.com", &v1.TypingEvent{ToEmail: "Bob@Example.com"}, true)
+
+	if bob.last.GetTypingStarted().GetFromEmail() != "alice@example.com" {
+		t.Fatalf("expected typing_started from alice, got %v", bob.last)
+	}
+
+	s.relayTyping(context.Background(), "alice@example.com", &v1.TypingEvent{ToEmail: "bob@example.com"}, false)
+	if bob.last.GetTypingStopped() == nil {
+		t.Fatalf("expected typing_stopped, got %v", bob.last)
+	}
+}
+
+func TestTypingLimiter_Throttles(t *testing.T) {
+	l := newTypingLimiter()
+	allowed := 0
+	for range typingBurst * 2 {
+		if l.Allow() {
+			allowed++
+		}
+	}
+	if allowed != typingBurst {
+		t.Fatalf("expected %d typing events to pass, got %d", typingBurst, allowed)
+	}
+}
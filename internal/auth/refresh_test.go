@@ -0,0 +1,24 @@
+package auth
+
+import "testing"
+
+func TestNewRefreshToken(t *testing.T) {
+	token, hash, err := NewRefreshToken()
+	if err != nil {
+		t.Fatalf("NewRefreshToken failed: %v", err)
+	}
+	if token == "" || hash == token {
+		t.Fatalf("expected an opaque token and a distinct hash, got %q / %q", token, hash)
+	}
+	if HashRefreshToken(token) != hash {
+		t.Fatal("HashRefreshToken does not match the hash returned with the token")
+	}
+
+	other, _, err := NewRefreshToken()
+	if err != nil {
+		t.Fatalf("NewRefreshToken failed: %v", err)
+	}
+	if other == token {
+		t.Fatal("expected a fresh token on every call")
+	}
+}
@@ -0,0 +1,23 @@
+// Package blob stores opaque binary contents, such as chat attachments, behind
+// a small interface with local filesystem and MongoDB GridFS backends.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when opening a blob that doesn't exist.
+var ErrNotFound = errors.New("blob not found")
+
+// Store persists blobs by ID.
+type Store interface {
+	// Put writes everything read from r to a new blob and returns its size.
+	// A failed Put leaves no partial blob behind.
+	Put(ctx context.Context, id string, r io.Reader) (int64, error)
+	// Open returns a reader for the blob's contents.
+	Open(ctx context.Context, id string) (io.ReadCloser, error)
+	// Delete removes a blob. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,78 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFSStore_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSStore failed: %v", err)
+	}
+
+	n, err := s.Put(ctx, "abc123", strings.NewReader("hello world"))
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if n != 11 {
+		t.Fatalf("expected 11 bytes written, got %d", n)
+	}
+
+	rc, err := s.Open(ctx, "abc123")
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	got, err := io.ReadAll(rc)
+	_ = rc.Close()
+	if err != nil || string(got) != "hello world" {
+		t.Fatalf("unexpected contents %q (err %v)", got, err)
+	}
+
+	if err := s.Delete(ctx, "abc123"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := s.Open(ctx, "abc123"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+	// deleting again is not an error
+	if err := s.Delete(ctx, "abc123"); err != nil {
+		t.Fatalf("second Delete failed: %v", err)
+	}
+}
+
+func TestFSStore_FailedPutLeavesNothing(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSStore failed: %v", err)
+	}
+
+	r := io.MultiReader(strings.NewReader("partial"), &failingReader{})
+	if _, err := s.Put(ctx, "broken", r); err == nil {
+		t.Fatalf("expected Put to fail")
+	}
+	if _, err := s.Open(ctx, "broken"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected no blob after failed Put, got %v", err)
+	}
+}
+
+func TestFSStore_RejectsPathIDs(t *testing.T) {
+	s, err := NewFSStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFSStore failed: %v", err)
+	}
+	for _, id := range []string{"", "../escape", "a/b", ".hidden"} {
+		if _, err := s.Open(context.Background(), id); err == nil || errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected invalid id error for %q, got %v", id, err)
+		}
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
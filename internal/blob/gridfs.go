@@ -0,0 +1,63 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// GridFSStore keeps blobs in a MongoDB GridFS bucket, using the blob ID as
+// both file ID and filename.
+type GridFSStore struct {
+	// bucket is the GridFS bucket holding blob files and chunks
+	bucket *mongo.GridFSBucket
+}
+
+// NewGridFSStore returns a GridFSStore using the provided bucket.
+func NewGridFSStore(bucket *mongo.GridFSBucket) *GridFSStore {
+	return &GridFSStore{bucket: bucket}
+}
+
+// Put streams r into a new GridFS file. The driver aborts the upload and
+// removes written chunks if reading r fails.
+func (s *GridFSStore) Put(ctx context.Context, id string, r io.Reader) (int64, error) {
+	cr := &countingReader{r: r}
+	if err := s.bucket.UploadFromStreamWithID(ctx, id, id, cr); err != nil {
+		return 0, err
+	}
+	return cr.n, nil
+}
+
+// Open opens a download stream for the blob.
+func (s *GridFSStore) Open(ctx context.Context, id string) (io.ReadCloser, error) {
+	ds, err := s.bucket.OpenDownloadStream(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrFileNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return ds, nil
+}
+
+// Delete removes the blob's file and chunks.
+func (s *GridFSStore) Delete(ctx context.Context, id string) error {
+	if err := s.bucket.Delete(ctx, id); err != nil && !errors.Is(err, mongo.ErrFileNotFound) {
+		return err
+	}
+	return nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// ErrAttachmentNotFound is returned when an attachment lookup matches nothing.
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// AttachmentsStore performs attachment metadata DB operations. File contents
+// are kept in a blob.Store, not here.
+type AttachmentsStore struct {
+	// coll is reference to "attachments" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewAttachmentsStore returns an AttachmentsStore using the provided collection.
+func NewAttachmentsStore(coll *mongo.Collection) *AttachmentsStore {
+	return &AttachmentsStore{coll: coll}
+}
+
+// CreateAttachment inserts metadata for an uploaded attachment. The caller
+// chooses the ID so it can match the blob written beforehand.
+func (a *AttachmentsStore) CreateAttachment(ctx context.Context, att *Attachment) error {
+	att.OwnerEmail = normalize.Email(att.OwnerEmail)
+	_, err := a.coll.InsertOne(ctx, att)
+	return err
+}
+
+// GetAttachment fetches attachment metadata by ID.
+func (a *AttachmentsStore) GetAttachment(ctx context.Context, id bson.ObjectID) (*Attachment, error) {
+	var att Attachment
+	err := a.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&att)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+	return &att, nil
+}
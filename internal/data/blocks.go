@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// BlocksStore performs user block DB operations.
+type BlocksStore struct {
+	// coll is reference to "blocks" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewBlocksStore returns a BlocksStore using the provided collection.
+func NewBlocksStore(coll *mongo.Collection) *BlocksStore {
+	return &BlocksStore{coll: coll}
+}
+
+// Block records that blocker has blocked blocked and returns the block.
+// Blocking someone twice keeps the original block.
+func (b *BlocksStore) Block(ctx context.Context, blocker, blocked string) (*Block, error) {
+	filter := bson.M{
+		"blocker_email": normalize.Email(blocker),
+		"blocked_email": normalize.Email(blocked),
+	}
+	update := bson.M{"$setOnInsert": bson.M{"created_at": time.Now()}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var block Block
+	if err := b.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&block); err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// Unblock removes blocker's block on blocked, if any.
+func (b *BlocksStore) Unblock(ctx context.Context, blocker, blocked string) error {
+	_, err := b.coll.DeleteOne(ctx, bson.M{
+		"blocker_email": normalize.Email(blocker),
+		"blocked_email": normalize.Email(blocked),
+	})
+	return err
+}
+
+// ListBlocked returns every user blocker has blocked, most recent first.
+func (b *BlocksStore) ListBlocked(ctx context.Context, blocker string) ([]*Block, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := b.coll.Find(ctx, bson.M{"blocker_email": normalize.Email(blocker)}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var blocks []*Block
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// BlockBetween returns a block of either user by the other, or nil if neither
+// has blocked the other.
+func (b *BlocksStore) BlockBetween(ctx context.Context, a, c string) (*Block, error) {
+	a = normalize.Email(a)
+	c = normalize.Email(c)
+	filter := bson.M{"$or": bson.A{
+		bson.M{"blocker_email": a, "blocked_email": c},
+		bson.M{"blocker_email": c, "blocked_email": a},
+	}}
+
+	var block Block
+	err := b.coll.FindOne(ctx, filter).Decode(&block)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &block, nil
+}
+
+// BlockedWith returns every user email has blocked or been blocked by.
+func (b *BlocksStore) BlockedWith(ctx context.Context, email string) ([]string, error) {
+	email = normalize.Email(email)
+	return b.others(ctx, email, bson.M{"$or": bson.A{
+		bson.M{"blocker_email": email},
+		bson.M{"blocked_email": email},
+	}})
+}
+
+// BlockedAmong returns those of emails that have blocked email or been
+// blocked by it, in one query.
+func (b *BlocksStore) BlockedAmong(ctx context.Context, email string, emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+	email = normalize.Email(email)
+	normalized := make([]string, 0, len(emails))
+	for _, e := range emails {
+		normalized = append(normalized, normalize.Email(e))
+	}
+	return b.others(ctx, email, bson.M{"$or": bson.A{
+		bson.M{"blocker_email": email, "blocked_email": bson.M{"$in": normalized}},
+		bson.M{"blocker_email": bson.M{"$in": normalized}, "blocked_email": email},
+	}})
+}
+
+// others returns the user on the other side of email in every block matching
+// filter, without duplicates.
+func (b *BlocksStore) others(ctx context.Context, email string, filter bson.M) ([]string, error) {
+	cursor, err := b.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var blocks []*Block
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(blocks))
+	emails := make([]string, 0, len(blocks))
+	for _, bl := range blocks {
+		other := bl.BlockedEmail
+		if other == email {
+			other = bl.BlockerEmail
+		}
+		if !seen[other] {
+			seen[other] = true
+			emails = append(emails, other)
+		}
+	}
+	return emails, nil
+}
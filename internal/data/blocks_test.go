@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+)
+
+func TestBlocks(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.BlocksCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+	blocks := NewBlocksStore(c.BlocksCollection())
+
+	first, err := blocks.Block(ctx, "alice@example.com", "Bob@Example.com")
+	if err != nil {
+		t.Fatalf("Block failed: %v", err)
+	}
+	again, err := blocks.Block(ctx, "alice@example.com", "bob@example.com")
+	if err != nil {
+		t.Fatalf("second Block failed: %v", err)
+	}
+	if again.ID != first.ID || !again.CreatedAt.Equal(first.CreatedAt) {
+		t.Fatalf("expected blocking twice to keep the original block, got %+v and %+v", first, again)
+	}
+
+	// the block is visible from either side
+	for _, pair := range [][2]string{{"alice@example.com", "bob@example.com"}, {"bob@example.com", "alice@example.com"}} {
+		b, err := blocks.BlockBetween(ctx, pair[0], pair[1])
+		if err != nil || b == nil || b.BlockerEmail != "alice@example.com" {
+			t.Fatalf("BlockBetween(%s, %s) = %+v, %v", pair[0], pair[1], b, err)
+		}
+	}
+	if b, err := blocks.BlockBetween(ctx, "alice@example.com", "carol@example.com"); err != nil || b != nil {
+		t.Fatalf("expected no block with carol, got %+v, %v", b, err)
+	}
+
+	list, err := blocks.ListBlocked(ctx, "alice@example.com")
+	if err != nil || len(list) != 1 || list[0].BlockedEmail != "bob@example.com" {
+		t.Fatalf("unexpected blocked list: %+v, %v", list, err)
+	}
+
+	// both directions count, in one query
+	if _, err := blocks.Block(ctx, "carol@example.com", "alice@example.com"); err != nil {
+		t.Fatalf("Block failed: %v", err)
+	}
+	with, err := blocks.BlockedWith(ctx, "Alice@Example.com")
+	if err != nil || !slices.Equal(slices.Sorted(slices.Values(with)), []string{"bob@example.com", "carol@example.com"}) {
+		t.Fatalf("unexpected BlockedWith: %v, %v", with, err)
+	}
+	among, err := blocks.BlockedAmong(ctx, "alice@example.com", []string{"Carol@example.com", "dave@example.com"})
+	if err != nil || !slices.Equal(among, []string{"carol@example.com"}) {
+		t.Fatalf("unexpected BlockedAmong: %v, %v", among, err)
+	}
+	if err := blocks.Unblock(ctx, "carol@example.com", "alice@example.com"); err != nil {
+		t.Fatalf("Unblock failed: %v", err)
+	}
+
+	if err := blocks.Unblock(ctx, "alice@example.com", "bob@example.com"); err != nil {
+		t.Fatalf("Unblock failed: %v", err)
+	}
+	if b, err := blocks.BlockBetween(ctx, "bob@example.com", "alice@example.com"); err != nil || b != nil {
+		t.Fatalf("expected block to be lifted, got %+v, %v", b, err)
+	}
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrContactNotFound is returned when a contact or message request lookup matches nothing.
+var ErrContactNotFound = errors.New("contact not found")
+
+// ContactsStore performs contact and message request DB operations.
+type ContactsStore struct {
+	// coll is reference to "contacts" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewContactsStore returns a ContactsStore using the provided collection.
+func NewContactsStore(coll *mongo.Collection) *ContactsStore {
+	return &ContactsStore{coll: coll}
+}
+
+// GetContact returns owner's relationship with contact.
+func (c *ContactsStore) GetContact(ctx context.Context, owner, contact string) (*Contact, error) {
+	var ct Contact
+	err := c.coll.FindOne(ctx, contactFilter(owner, contact)).Decode(&ct)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrContactNotFound
+		}
+		return nil, err
+	}
+	return &ct, nil
+}
+
+// Accept makes contact an accepted contact of owner and returns the previous
+// status, or "" if there was none. Accepting a request clears its summary.
+func (c *ContactsStore) Accept(ctx context.Context, owner, contact string) (string, error) {
+	now := time.Now()
+	update := bson.M{
+		"$set":         bson.M{"status": ContactAccepted, "updated_at": now},
+		"$setOnInsert": bson.M{"created_at": now},
+		"$unset":       bson.M{"last_message": "", "last_message_at": "", "message_count": ""},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+
+	var prev Contact
+	err := c.coll.FindOneAndUpdate(ctx, contactFilter(owner, contact), update, opts).Decode(&prev)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", nil
+		}
+		return "", err
+	}
+	return prev.Status, nil
+}
+
+// RecordRequest adds msg, a pending message from contact, to owner's request
+// from contact, opening the request if needed. A declined request stays
+// declined but keeps counting.
+func (c *ContactsStore) RecordRequest(ctx context.Context, owner, contact string, msg *Message) error {
+	now := time.Now()
+	filter := contactFilter(owner, contact)
+	filter["status"] = bson.M{"$ne": ContactAccepted}
+	update := bson.M{
+		"$set": bson.M{
+			"last_message":    msg.Content,
+			"last_message_at": msg.SentAt,
+			"updated_at":      now,
+		},
+		"$inc":         bson.M{"message_count": 1},
+		"$setOnInsert": bson.M{"status": ContactPending, "created_at": now},
+	}
+	_, err := c.coll.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	return err
+}
+
+// Decline marks owner's pending request from contact declined.
+func (c *ContactsStore) Decline(ctx context.Context, owner, contact string) error {
+	filter := contactFilter(owner, contact)
+	filter["status"] = ContactPending
+	result, err := c.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"status": ContactDeclined, "updated_at": time.Now()}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrContactNotFound
+	}
+	return nil
+}
+
+// ListRequests returns owner's pending message requests, most recent first.
+func (c *ContactsStore) ListRequests(ctx context.Context, owner string, limit int64) ([]*Contact, error) {
+	filter := bson.M{"owner_email": normalize.Email(owner), "status": ContactPending}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "last_message_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := c.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var requests []*Contact
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// contactFilter matches owner's relationship with contact.
+func contactFilter(owner, contact string) bson.M {
+	return bson.M{
+		"owner_email":   normalize.Email(owner),
+		"contact_email": normalize.Email(contact),
+	}
+}
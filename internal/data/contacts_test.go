@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+)
+
+func TestContactsRequests(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.ContactsCollection().Drop(ctx)
+	_ = c.MessagesCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+	contacts := NewContactsStore(c.ContactsCollection())
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	// two pending messages from a stranger make one request
+	now := time.Now()
+	for i, content := range []string{"hi", "are you there?"} {
+		m, err := msgs.InsertMessage(ctx, &Message{FromEmail: "stranger@example.com", ToEmail: "bob@example.com", Content: content, SentAt: now.Add(time.Duration(i) * time.Second), Pending: true})
+		if err != nil {
+			t.Fatalf("InsertMessage failed: %v", err)
+		}
+		if err := contacts.RecordRequest(ctx, "bob@example.com", "stranger@example.com", m); err != nil {
+			t.Fatalf("RecordRequest failed: %v", err)
+		}
+	}
+	requests, err := contacts.ListRequests(ctx, "bob@example.com", 10)
+	if err != nil {
+		t.Fatalf("ListRequests failed: %v", err)
+	}
+	if len(requests) != 1 || requests[0].MessageCount != 2 || requests[0].LastMessage != "are you there?" {
+		t.Fatalf("unexpected requests: %+v", requests)
+	}
+
+	// pending messages aren't redelivered to the recipient
+	undelivered, err := msgs.GetUndelivered(ctx, "bob@example.com", nil, 10)
+	if err != nil || len(undelivered) != 0 {
+		t.Fatalf("expected no undelivered messages, got %+v, %v", undelivered, err)
+	}
+
+	// ...nor shown to the recipient anywhere else until accepted, while the
+	// sender still sees what they sent
+	history, err := msgs.GetMessageHistory(ctx, "bob@example.com", "stranger@example.com", nil, 10)
+	if err != nil || len(history) != 0 {
+		t.Fatalf("expected no history for the recipient, got %+v, %v", history, err)
+	}
+	history, err = msgs.GetMessageHistory(ctx, "stranger@example.com", "bob@example.com", nil, 10)
+	if err != nil || len(history) != 2 {
+		t.Fatalf("expected the sender to see both messages, got %+v, %v", history, err)
+	}
+	found, err := msgs.SearchMessages(ctx, MessageSearch{Query: "there", Viewer: "bob@example.com", Limit: 10})
+	if err != nil || len(found) != 0 {
+		t.Fatalf("expected search to skip pending messages, got %+v, %v", found, err)
+	}
+	thread, err := msgs.GetThread(ctx, history[0].ID, "bob@example.com", 10)
+	if err != nil || len(thread) != 0 {
+		t.Fatalf("expected no thread for the recipient, got %+v, %v", thread, err)
+	}
+	received, err := msgs.CountReceived(ctx, "bob@example.com", "stranger@example.com", time.Time{}, now.Add(time.Minute))
+	if err != nil || received != 0 {
+		t.Fatalf("CountReceived = %d, %v; want 0", received, err)
+	}
+
+	pending, err := msgs.GetPending(ctx, "stranger@example.com", "bob@example.com", nil, 10)
+	if err != nil || len(pending) != 2 || pending[0].Content != "hi" {
+		t.Fatalf("unexpected pending messages: %+v, %v", pending, err)
+	}
+	// pages continue after the cursor
+	rest, err := msgs.GetPending(ctx, "stranger@example.com", "bob@example.com", CursorAt(pending[0], true), 10)
+	if err != nil || len(rest) != 1 || rest[0].ID != pending[1].ID {
+		t.Fatalf("unexpected pending page: %+v, %v", rest, err)
+	}
+	prev, err := contacts.Accept(ctx, "bob@example.com", "stranger@example.com")
+	if err != nil || prev != ContactPending {
+		t.Fatalf("Accept = %q, %v; want pending", prev, err)
+	}
+	if err := msgs.ClearPending(ctx, "stranger@example.com", "bob@example.com"); err != nil {
+		t.Fatalf("ClearPending failed: %v", err)
+	}
+	undelivered, err = msgs.GetUndelivered(ctx, "bob@example.com", nil, 10)
+	if err != nil || len(undelivered) != 2 {
+		t.Fatalf("expected accepted messages to be undelivered, got %+v, %v", undelivered, err)
+	}
+
+	// an accepted contact no longer records requests or shows up in the list
+	requests, err = contacts.ListRequests(ctx, "bob@example.com", 10)
+	if err != nil || len(requests) != 0 {
+		t.Fatalf("expected no requests after accepting, got %+v, %v", requests, err)
+	}
+
+	// declined requests leave the list but stay declined
+	m, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "spam@example.com", ToEmail: "bob@example.com", Content: "buy", SentAt: now, Pending: true})
+	_ = contacts.RecordRequest(ctx, "bob@example.com", "spam@example.com", m)
+	if err := contacts.Decline(ctx, "bob@example.com", "spam@example.com"); err != nil {
+		t.Fatalf("Decline failed: %v", err)
+	}
+	_ = contacts.RecordRequest(ctx, "bob@example.com", "spam@example.com", m)
+	declined, err := contacts.GetContact(ctx, "bob@example.com", "spam@example.com")
+	if err != nil || declined.Status != ContactDeclined {
+		t.Fatalf("expected declined request, got %+v, %v", declined, err)
+	}
+	if err := contacts.Decline(ctx, "bob@example.com", "spam@example.com"); err != ErrContactNotFound {
+		t.Fatalf("declining twice: got %v, want ErrContactNotFound", err)
+	}
+}
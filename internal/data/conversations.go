@@ -0,0 +1,368 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrConversationNotFound is returned when a conversation lookup matches nothing.
+var ErrConversationNotFound = errors.New("conversation not found")
+
+// ConversationsStore performs per-user conversation state DB operations.
+type ConversationsStore struct {
+	// coll is reference to "conversations" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewConversationsStore returns a ConversationsStore using the provided collection.
+func NewConversationsStore(coll *mongo.Collection) *ConversationsStore {
+	return &ConversationsStore{coll: coll}
+}
+
+// MarkRead advances owner's read watermark in their 1-on-1 chat with partner
+// and returns the previous watermark. The watermark never moves backwards.
+func (c *ConversationsStore) MarkRead(ctx context.Context, owner, partner string, readAt time.Time) (time.Time, error) {
+	return c.markRead(ctx, directFilter(owner, partner), readAt)
+}
+
+// MarkGroupRead advances owner's read watermark in a group and returns the
+// previous watermark.
+func (c *ConversationsStore) MarkGroupRead(ctx context.Context, owner string, groupID bson.ObjectID, readAt time.Time) (time.Time, error) {
+	return c.markRead(ctx, groupFilter(owner, groupID), readAt)
+}
+
+// markRead upserts the conversation matched by filter; equality fields of the
+// filter become the new document's keys.
+func (c *ConversationsStore) markRead(ctx context.Context, filter bson.M, readAt time.Time) (time.Time, error) {
+	update := bson.M{
+		// $max keeps the later watermark if receipts arrive out of order
+		"$max": bson.M{"read_at": readAt},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+	// Return the document as it was before the update to learn the old watermark
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+
+	var prev Conversation
+	err := c.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&prev)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Freshly inserted: nothing was read before
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return prev.ReadAt, nil
+}
+
+// ReduceUnread subtracts n newly read messages from owner's unread count in
+// their chat with partner.
+func (c *ConversationsStore) ReduceUnread(ctx context.Context, owner, partner string, n int64) error {
+	return c.reduceUnread(ctx, directFilter(owner, partner), n)
+}
+
+// ReduceGroupUnread subtracts n newly read messages from owner's unread count in a group.
+func (c *ConversationsStore) ReduceGroupUnread(ctx context.Context, owner string, groupID bson.ObjectID, n int64) error {
+	return c.reduceUnread(ctx, groupFilter(owner, groupID), n)
+}
+
+// reduceUnread decrements unread_count without letting it drop below zero,
+// which conversations that predate unread counting would otherwise do.
+func (c *ConversationsStore) reduceUnread(ctx context.Context, filter bson.M, n int64) error {
+	// An aggregation pipeline update so the clamp happens atomically on the server
+	update := mongo.Pipeline{
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "unread_count", Value: bson.D{{Key: "$max", Value: bson.A{
+				0,
+				bson.D{{Key: "$subtract", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$unread_count", 0}}}, n}}},
+			}}}},
+		}}},
+	}
+	_, err := c.coll.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// RecordMessage updates the conversation summary of every participant with
+// msg as the last message and counts it as unread for everyone but its sender.
+// Participants are the sender and recipient for 1-on-1 messages, the current
+// members for group messages.
+func (c *ConversationsStore) RecordMessage(ctx context.Context, msg *Message, participants []string) error {
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(participants))
+	for _, email := range participants {
+		var filter bson.M
+		if msg.GroupID.IsZero() {
+			partner := msg.ToEmail
+			if email == msg.ToEmail {
+				partner = msg.FromEmail
+			}
+			filter = directFilter(email, partner)
+		} else {
+			filter = groupFilter(email, msg.GroupID)
+		}
+
+		set := bson.M{
+			"last_msg_id":     msg.ID,
+			"last_message":    msg.Content,
+			"last_from_email": msg.FromEmail,
+			"last_message_at": msg.SentAt,
+			"updated_at":      now,
+		}
+		update := bson.M{"$set": set}
+		if msg.ExpiresAt.IsZero() {
+			update["$unset"] = bson.M{"last_expires_at": ""}
+		} else {
+			set["last_expires_at"] = msg.ExpiresAt
+		}
+		if email != msg.FromEmail {
+			update["$inc"] = bson.M{"unread_count": 1}
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	// Unordered so one failing participant doesn't stop the others
+	_, err := c.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// UpdateLastMessage refreshes the preview of every conversation whose last
+// message is msg, after an edit or delete-for-everyone. It reports whether
+// any preview showed msg.
+func (c *ConversationsStore) UpdateLastMessage(ctx context.Context, msg *Message) (bool, error) {
+	result, err := c.coll.UpdateMany(ctx,
+		bson.M{"last_msg_id": msg.ID},
+		bson.M{"$set": bson.M{"last_message": msg.Content, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// ReplaceLastMessage moves owner's preview off hiddenID, a message they deleted
+// for themselves, onto latest: the newest message they can still see, or nil
+// to clear the preview when nothing is left. It reports whether the preview
+// showed hiddenID.
+func (c *ConversationsStore) ReplaceLastMessage(ctx context.Context, owner string, hiddenID bson.ObjectID, latest *Message) (bool, error) {
+	filter := bson.M{"owner_email": normalize.Email(owner), "last_msg_id": hiddenID}
+	update := bson.M{
+		"$set": bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{
+			"last_msg_id": "", "last_message": "", "last_from_email": "",
+			"last_message_at": "", "last_expires_at": "",
+		},
+	}
+	if latest != nil {
+		set := bson.M{
+			"last_msg_id":     latest.ID,
+			"last_message":    latest.Content,
+			"last_from_email": latest.FromEmail,
+			"last_message_at": latest.SentAt,
+			"updated_at":      time.Now(),
+		}
+		update = bson.M{"$set": set, "$unset": bson.M{"last_expires_at": ""}}
+		if !latest.ExpiresAt.IsZero() {
+			set["last_expires_at"] = latest.ExpiresAt
+			delete(update, "$unset")
+		}
+	}
+
+	result, err := c.coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// Backfill stores summaries built by MessagesStore.ConversationSummaries for
+// conversations that have no last message yet. Conversations already showing
+// a message keep it and read state is never touched, so running it again is
+// harmless. It returns how many conversations were filled in.
+func (c *ConversationsStore) Backfill(ctx context.Context, summaries []*Conversation) (int64, error) {
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, 2*len(summaries))
+	for _, sum := range summaries {
+		var filter, bare bson.M
+		if sum.GroupID.IsZero() {
+			filter = directFilter(sum.OwnerEmail, sum.PartnerEmail)
+			bare = directFilter(sum.OwnerEmail, sum.PartnerEmail)
+		} else {
+			filter = groupFilter(sum.OwnerEmail, sum.GroupID)
+			bare = groupFilter(sum.OwnerEmail, sum.GroupID)
+		}
+		// MarkRead and SetExpiry may have created the document without a message
+		bare["last_message_at"] = bson.M{"$exists": false}
+
+		set := bson.M{
+			"last_msg_id":     sum.LastMsgID,
+			"last_message":    sum.LastMessage,
+			"last_from_email": sum.LastFromEmail,
+			"last_message_at": sum.LastMessageAt,
+			"updated_at":      now,
+		}
+		if !sum.LastExpiresAt.IsZero() {
+			set["last_expires_at"] = sum.LastExpiresAt
+		}
+		models = append(models,
+			mongo.NewUpdateOneModel().SetFilter(bare).SetUpdate(bson.M{"$set": set}),
+			mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(bson.M{"$setOnInsert": set}).SetUpsert(true),
+		)
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+
+	// Unordered so one failing conversation doesn't stop the others
+	result, err := c.coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount + result.UpsertedCount, nil
+}
+
+// SetExpiry stores the disappearing messages setting of the 1-on-1 chat between
+// a and b on both participants' conversations; zero seconds turns it off.
+func (c *ConversationsStore) SetExpiry(ctx context.Context, a, b string, seconds int64) error {
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, 2)
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		update := bson.M{"$set": bson.M{"expiry_seconds": seconds, "updated_at": now}}
+		if seconds == 0 {
+			update = bson.M{
+				"$set":   bson.M{"updated_at": now},
+				"$unset": bson.M{"expiry_seconds": ""},
+			}
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(directFilter(pair[0], pair[1])).SetUpdate(update).SetUpsert(true))
+	}
+	_, err := c.coll.BulkWrite(ctx, models)
+	return err
+}
+
+// GetConversation returns owner's 1-on-1 conversation with partner.
+func (c *ConversationsStore) GetConversation(ctx context.Context, owner, partner string) (*Conversation, error) {
+	return c.findOne(ctx, directFilter(owner, partner))
+}
+
+// GetGroupConversation returns owner's conversation in a group.
+func (c *ConversationsStore) GetGroupConversation(ctx context.Context, owner string, groupID bson.ObjectID) (*Conversation, error) {
+	return c.findOne(ctx, groupFilter(owner, groupID))
+}
+
+// findOne returns the conversation matched by filter.
+func (c *ConversationsStore) findOne(ctx context.Context, filter bson.M) (*Conversation, error) {
+	var conv Conversation
+	err := c.coll.FindOne(ctx, filter).Decode(&conv)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrConversationNotFound
+		}
+		return nil, err
+	}
+	return &conv, nil
+}
+
+// ListConversations returns owner's conversations that have messages, most
+// recently active first, leaving out 1-on-1 chats with the hidden partners and
+// groups other than groupIDs, so a page isn't cut short by groups owner left.
+func (c *ConversationsStore) ListConversations(ctx context.Context, owner string, hidden []string, groupIDs []bson.ObjectID, limit int64) ([]*Conversation, error) {
+	if groupIDs == nil {
+		groupIDs = []bson.ObjectID{}
+	}
+	filter := bson.M{
+		"owner_email":     normalize.Email(owner),
+		"last_message_at": bson.M{"$exists": true},
+		"$or": bson.A{
+			bson.M{"group_id": bson.M{"$exists": false}},
+			bson.M{"group_id": bson.M{"$in": groupIDs}},
+		},
+	}
+	if len(hidden) > 0 {
+		partners := make([]string, 0, len(hidden))
+		for _, e := range hidden {
+			partners = append(partners, normalize.Email(e))
+		}
+		filter["partner_email"] = bson.M{"$nin": partners}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "last_message_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := c.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var convs []*Conversation
+	if err := cursor.All(ctx, &convs); err != nil {
+		return nil, err
+	}
+	return convs, nil
+}
+
+// DeleteGroupConversation removes owner's state in a group they no longer belong to.
+func (c *ConversationsStore) DeleteGroupConversation(ctx context.Context, owner string, groupID bson.ObjectID) error {
+	_, err := c.coll.DeleteOne(ctx, groupFilter(owner, groupID))
+	return err
+}
+
+// directFilter matches owner's 1-on-1 conversation with partner.
+func directFilter(owner, partner string) bson.M {
+	return bson.M{
+		"owner_email":   normalize.Email(owner),
+		"partner_email": normalize.Email(partner),
+	}
+}
+
+// groupFilter matches owner's conversation in a group.
+func groupFilter(owner string, groupID bson.ObjectID) bson.M {
+	return bson.M{
+		"owner_email": normalize.Email(owner),
+		"group_id":    groupID,
+	}
+}
+
+// GetReadAt returns owner's read watermark in their chat with partner, or the
+// zero time if owner has never read anything there.
+func (c *ConversationsStore) GetReadAt(ctx context.Context, owner, partner string) (time.Time, error) {
+	var conv Conversation
+	err := c.coll.FindOne(ctx, directFilter(owner, partner)).Decode(&conv)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return conv.ReadAt, nil
+}
+
+// GetGroupReadAt returns the read watermark of every member who has read
+// something in the group, keyed by email.
+func (c *ConversationsStore) GetGroupReadAt(ctx context.Context, groupID bson.ObjectID) (map[string]time.Time, error) {
+	cursor, err := c.coll.Find(ctx, bson.M{"group_id": groupID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var convs []*Conversation
+	if err := cursor.All(ctx, &convs); err != nil {
+		return nil, err
+	}
+
+	readAt := make(map[string]time.Time, len(convs))
+	for _, conv := range convs {
+		readAt[conv.OwnerEmail] = conv.ReadAt
+	}
+	return readAt, nil
+}
@@ -0,0 +1,346 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestConversationsReadWatermark(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.ConversationsCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	convs := NewConversationsStore(c.ConversationsCollection())
+
+	// nothing read yet
+	readAt, err := convs.GetReadAt(ctx, "bob@example.com", "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetReadAt failed: %v", err)
+	}
+	if !readAt.IsZero() {
+		t.Fatalf("expected zero watermark, got %v", readAt)
+	}
+
+	later := time.Now().Truncate(time.Millisecond)
+	earlier := later.Add(-time.Minute)
+	if _, err := convs.MarkRead(ctx, "BOB@example.com", "alice@example.com", later); err != nil {
+		t.Fatalf("MarkRead failed: %v", err)
+	}
+	// an out-of-order receipt must not move the watermark backwards
+	prev, err := convs.MarkRead(ctx, "bob@example.com", "alice@example.com", earlier)
+	if err != nil {
+		t.Fatalf("MarkRead failed: %v", err)
+	}
+	if !prev.Equal(later) {
+		t.Fatalf("expected previous watermark %v, got %v", later, prev)
+	}
+	readAt, err = convs.GetReadAt(ctx, "bob@example.com", "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetReadAt failed: %v", err)
+	}
+	if !readAt.Equal(later) {
+		t.Fatalf("expected watermark %v, got %v", later, readAt)
+	}
+
+	groupID := bson.NewObjectID()
+	if _, err := convs.MarkGroupRead(ctx, "bob@example.com", groupID, later); err != nil {
+		t.Fatalf("MarkGroupRead failed: %v", err)
+	}
+	groupRead, err := convs.GetGroupReadAt(ctx, groupID)
+	if err != nil {
+		t.Fatalf("GetGroupReadAt failed: %v", err)
+	}
+	if len(groupRead) != 1 || !groupRead["bob@example.com"].Equal(later) {
+		t.Fatalf("unexpected group watermarks: %v", groupRead)
+	}
+}
+
+func TestConversationsSummary(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.ConversationsCollection().Drop(ctx)
+	_ = c.MessagesCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	convs := NewConversationsStore(c.ConversationsCollection())
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	// alice sends bob three messages
+	now := time.Now().Truncate(time.Millisecond)
+	var sent []*Message
+	for i := range 3 {
+		m, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "hi", now.Add(time.Duration(i)*time.Second))
+		if err != nil {
+			t.Fatalf("SaveMessage failed: %v", err)
+		}
+		if err := convs.RecordMessage(ctx, m, []string{"alice@example.com", "bob@example.com"}); err != nil {
+			t.Fatalf("RecordMessage failed: %v", err)
+		}
+		sent = append(sent, m)
+	}
+
+	bobs, err := convs.ListConversations(ctx, "bob@example.com", nil, nil, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if len(bobs) != 1 || bobs[0].PartnerEmail != "alice@example.com" || bobs[0].UnreadCount != 3 || bobs[0].LastMsgID != sent[2].ID {
+		t.Fatalf("unexpected bob summary: %+v", bobs)
+	}
+	alices, err := convs.ListConversations(ctx, "alice@example.com", nil, nil, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if len(alices) != 1 || alices[0].UnreadCount != 0 {
+		t.Fatalf("sender should have nothing unread: %+v", alices)
+	}
+
+	// reading up to the second message leaves one unread
+	prev, err := convs.MarkRead(ctx, "bob@example.com", "alice@example.com", sent[1].SentAt)
+	if err != nil {
+		t.Fatalf("MarkRead failed: %v", err)
+	}
+	n, err := msgs.CountReceived(ctx, "bob@example.com", "alice@example.com", prev, sent[1].SentAt)
+	if err != nil {
+		t.Fatalf("CountReceived failed: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 newly read messages, got %d", n)
+	}
+	if err := convs.ReduceUnread(ctx, "bob@example.com", "alice@example.com", n); err != nil {
+		t.Fatalf("ReduceUnread failed: %v", err)
+	}
+	// over-reducing clamps at zero
+	if err := convs.ReduceUnread(ctx, "alice@example.com", "bob@example.com", 5); err != nil {
+		t.Fatalf("ReduceUnread failed: %v", err)
+	}
+
+	// edits refresh the preview
+	sent[2].Content = "edited"
+	updated, err := convs.UpdateLastMessage(ctx, sent[2])
+	if err != nil {
+		t.Fatalf("UpdateLastMessage failed: %v", err)
+	}
+	if !updated {
+		t.Fatalf("expected the last message preview to be refreshed")
+	}
+	bobs, err = convs.ListConversations(ctx, "bob@example.com", nil, nil, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if bobs[0].UnreadCount != 1 || bobs[0].LastMessage != "edited" {
+		t.Fatalf("unexpected bob summary after read: %+v", bobs[0])
+	}
+	alices, err = convs.ListConversations(ctx, "alice@example.com", nil, nil, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if alices[0].UnreadCount != 0 {
+		t.Fatalf("unread count should clamp at zero, got %d", alices[0].UnreadCount)
+	}
+}
+
+func TestConversationsBackfill(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.ConversationsCollection().Drop(ctx)
+	_ = c.MessagesCollection().Drop(ctx)
+	_ = c.GroupsCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	convs := NewConversationsStore(c.ConversationsCollection())
+	msgs := NewMessagesStore(c.MessagesCollection())
+	groups := NewGroupsStore(c.GroupsCollection())
+
+	// messages stored before conversation summaries existed
+	now := time.Now().Truncate(time.Millisecond)
+	first, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "hi", now)
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+	second, err := msgs.SaveMessage(ctx, "bob@example.com", "alice@example.com", "hey", now.Add(time.Second))
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+	if err := msgs.HideMessage(ctx, second.ID, "alice@example.com"); err != nil {
+		t.Fatalf("HideMessage failed: %v", err)
+	}
+	g, err := groups.CreateGroup(ctx, "team", "alice@example.com", []string{"carol@example.com"})
+	if err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "carol@example.com", GroupID: g.ID, Content: "standup", SentAt: now}); err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	// bob read something before summaries existed, so his document has no preview
+	if _, err := convs.MarkRead(ctx, "bob@example.com", "alice@example.com", now); err != nil {
+		t.Fatalf("MarkRead failed: %v", err)
+	}
+
+	var summaries []*Conversation
+	if err := msgs.ConversationSummaries(ctx, func(conv *Conversation) error {
+		summaries = append(summaries, conv)
+		return nil
+	}); err != nil {
+		t.Fatalf("ConversationSummaries failed: %v", err)
+	}
+	if len(summaries) != 4 {
+		t.Fatalf("expected summaries for alice, bob and both group members, got %d", len(summaries))
+	}
+	n, err := convs.Backfill(ctx, summaries)
+	if err != nil {
+		t.Fatalf("Backfill failed: %v", err)
+	}
+	if n != 4 {
+		t.Fatalf("expected 4 conversations filled in, got %d", n)
+	}
+
+	// alice deleted bob's reply for herself, so her preview is her own message
+	alices, err := convs.ListConversations(ctx, "alice@example.com", nil, []bson.ObjectID{g.ID}, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	// groups left behind are filtered in the query, before the limit
+	if left, err := convs.ListConversations(ctx, "alice@example.com", nil, nil, 1); err != nil || len(left) != 1 || !left[0].GroupID.IsZero() {
+		t.Fatalf("expected only the direct chat without the group, got %+v, %v", left, err)
+	}
+	if len(alices) != 2 {
+		t.Fatalf("expected a direct and a group chat for alice, got %d", len(alices))
+	}
+	for _, conv := range alices {
+		if conv.GroupID.IsZero() && conv.LastMsgID != first.ID {
+			t.Fatalf("expected alice's preview to skip her hidden message, got %+v", conv)
+		}
+		if !conv.GroupID.IsZero() && conv.LastMessage != "standup" {
+			t.Fatalf("unexpected group preview: %+v", conv)
+		}
+	}
+	bob, err := convs.GetConversation(ctx, "bob@example.com", "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetConversation failed: %v", err)
+	}
+	if bob.LastMsgID != second.ID || !bob.ReadAt.Equal(now) {
+		t.Fatalf("expected bob's preview filled in and watermark kept, got %+v", bob)
+	}
+
+	// running it again changes nothing
+	if n, err := convs.Backfill(ctx, summaries); err != nil || n != 0 {
+		t.Fatalf("expected a repeated backfill to be a no-op, got %d, %v", n, err)
+	}
+}
+
+func TestConversationsReplaceLastMessage(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.ConversationsCollection().Drop(ctx)
+	_ = c.MessagesCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	convs := NewConversationsStore(c.ConversationsCollection())
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now().Truncate(time.Millisecond)
+	var sent []*Message
+	for i, text := range []string{"one", "two"} {
+		m, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", text, now.Add(time.Duration(i)*time.Second))
+		if err != nil {
+			t.Fatalf("SaveMessage failed: %v", err)
+		}
+		if err := convs.RecordMessage(ctx, m, []string{"alice@example.com", "bob@example.com"}); err != nil {
+			t.Fatalf("RecordMessage failed: %v", err)
+		}
+		sent = append(sent, m)
+	}
+
+	// bob hides the last message: his preview falls back, alice's stays
+	replaced, err := convs.ReplaceLastMessage(ctx, "bob@example.com", sent[1].ID, sent[0])
+	if err != nil || !replaced {
+		t.Fatalf("expected bob's preview to be replaced, got %v, %v", replaced, err)
+	}
+	bob, err := convs.GetConversation(ctx, "bob@example.com", "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetConversation failed: %v", err)
+	}
+	if bob.LastMsgID != sent[0].ID || bob.LastMessage != "one" {
+		t.Fatalf("unexpected bob preview: %+v", bob)
+	}
+	alice, err := convs.GetConversation(ctx, "alice@example.com", "bob@example.com")
+	if err != nil {
+		t.Fatalf("GetConversation failed: %v", err)
+	}
+	if alice.LastMsgID != sent[1].ID {
+		t.Fatalf("alice's preview should be untouched: %+v", alice)
+	}
+
+	// hiding a message that isn't the preview does nothing
+	if replaced, err := convs.ReplaceLastMessage(ctx, "bob@example.com", sent[1].ID, nil); err != nil || replaced {
+		t.Fatalf("expected no change, got %v, %v", replaced, err)
+	}
+
+	// nothing left to show drops the chat from the list
+	if _, err := convs.ReplaceLastMessage(ctx, "bob@example.com", sent[0].ID, nil); err != nil {
+		t.Fatalf("ReplaceLastMessage failed: %v", err)
+	}
+	bobs, err := convs.ListConversations(ctx, "bob@example.com", nil, nil, 10)
+	if err != nil {
+		t.Fatalf("ListConversations failed: %v", err)
+	}
+	if len(bobs) != 0 {
+		t.Fatalf("expected bob's empty chat to be hidden, got %+v", bobs)
+	}
+}
@@ -0,0 +1,207 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrGroupNotFound is returned when a group lookup or update matches nothing.
+var ErrGroupNotFound = errors.New("group not found")
+
+// GroupsStore performs group DB operations.
+type GroupsStore struct {
+	// coll is reference to "groups" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewGroupsStore returns a GroupsStore using the provided collection.
+func NewGroupsStore(coll *mongo.Collection) *GroupsStore {
+	return &GroupsStore{coll: coll}
+}
+
+// CreateGroup inserts a new group owned by ownerEmail. The owner is always a
+// member; duplicate member emails are collapsed.
+func (g *GroupsStore) CreateGroup(ctx context.Context, name, ownerEmail string, memberEmails []string) (*Group, error) {
+	owner := normalize.Email(ownerEmail)
+
+	// Owner first, then the remaining members in request order without duplicates
+	members := []string{owner}
+	seen := map[string]bool{owner: true}
+	for _, e := range memberEmails {
+		e = normalize.Email(e)
+		if e == "" || seen[e] {
+			continue
+		}
+		seen[e] = true
+		members = append(members, e)
+	}
+
+	now := time.Now()
+	joins := make([]GroupJoin, 0, len(members))
+	for _, e := range members {
+		joins = append(joins, GroupJoin{Email: e, JoinedAt: now})
+	}
+	group := &Group{
+		Name:       name,
+		OwnerEmail: owner,
+		Members:    members,
+		Joins:      joins,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	result, err := g.coll.InsertOne(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	group.ID = result.InsertedID.(bson.ObjectID)
+	return group, nil
+}
+
+// GetGroup finds a group by ObjectID.
+func (g *GroupsStore) GetGroup(ctx context.Context, id bson.ObjectID) (*Group, error) {
+	var group Group
+	err := g.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// AddMember adds email to the group's members, recording when they joined and
+// dropping any invite they had, and returns the updated group. Adding an
+// existing member returns the group unchanged.
+func (g *GroupsStore) AddMember(ctx context.Context, id bson.ObjectID, email string) (*Group, error) {
+	email = normalize.Email(email)
+	now := time.Now()
+	group, err := g.updateWhere(ctx, bson.M{"_id": id, "members": bson.M{"$ne": email}}, bson.M{
+		"$push": bson.M{"members": email, "joins": GroupJoin{Email: email, JoinedAt: now}},
+		"$pull": bson.M{"invites": bson.M{"email": email}},
+		"$set":  bson.M{"updated_at": now},
+	})
+	if errors.Is(err, ErrGroupNotFound) {
+		return g.GetGroup(ctx, id)
+	}
+	return group, err
+}
+
+// RemoveMember removes email from the group's members, or withdraws their
+// invite, and returns the updated group.
+func (g *GroupsStore) RemoveMember(ctx context.Context, id bson.ObjectID, email string) (*Group, error) {
+	email = normalize.Email(email)
+	return g.update(ctx, id, bson.M{
+		"$pull": bson.M{"members": email, "invites": bson.M{"email": email}, "joins": bson.M{"email": email}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+}
+
+// Invite records an invite from invitedBy for email and returns the group.
+// created is false when email is already a member or invited, in which case
+// the group is returned unchanged.
+func (g *GroupsStore) Invite(ctx context.Context, id bson.ObjectID, email, invitedBy string) (group *Group, created bool, err error) {
+	email = normalize.Email(email)
+	now := time.Now()
+	filter := bson.M{
+		"_id":           id,
+		"members":       bson.M{"$ne": email},
+		"invites.email": bson.M{"$ne": email},
+	}
+	invite := GroupInvite{Email: email, InvitedBy: normalize.Email(invitedBy), InvitedAt: now}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated Group
+	err = g.coll.FindOneAndUpdate(ctx, filter, bson.M{
+		"$push": bson.M{"invites": invite},
+		"$set":  bson.M{"updated_at": now},
+	}, opts).Decode(&updated)
+	if err == mongo.ErrNoDocuments {
+		group, err = g.GetGroup(ctx, id)
+		return group, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &updated, true, nil
+}
+
+// AcceptInvites turns every invite invitedBy sent email into membership and
+// returns how many groups email joined.
+func (g *GroupsStore) AcceptInvites(ctx context.Context, email, invitedBy string) (int64, error) {
+	email = normalize.Email(email)
+	invite := bson.M{"email": email, "invited_by": normalize.Email(invitedBy)}
+	now := time.Now()
+	filter := bson.M{"invites": bson.M{"$elemMatch": invite}, "members": bson.M{"$ne": email}}
+	res, err := g.coll.UpdateMany(ctx, filter, bson.M{
+		"$pull": bson.M{"invites": bson.M{"email": email}},
+		"$push": bson.M{"members": email, "joins": GroupJoin{Email: email, JoinedAt: now}},
+		"$set":  bson.M{"updated_at": now},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// DropInvites withdraws every invite invitedBy sent email.
+func (g *GroupsStore) DropInvites(ctx context.Context, email, invitedBy string) error {
+	invite := bson.M{"email": normalize.Email(email), "invited_by": normalize.Email(invitedBy)}
+	_, err := g.coll.UpdateMany(ctx, bson.M{"invites": bson.M{"$elemMatch": invite}}, bson.M{
+		"$pull": bson.M{"invites": invite},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	return err
+}
+
+// SetOwner transfers group ownership to email, which must already be a member.
+func (g *GroupsStore) SetOwner(ctx context.Context, id bson.ObjectID, email string) (*Group, error) {
+	return g.update(ctx, id, bson.M{
+		"$set": bson.M{"owner_email": normalize.Email(email), "updated_at": time.Now()},
+	})
+}
+
+// ListGroupsForUser returns every group the user is a member of.
+func (g *GroupsStore) ListGroupsForUser(ctx context.Context, email string) ([]*Group, error) {
+	// members is an array field, so equality matches any element (multikey index)
+	cursor, err := g.coll.Find(ctx, bson.M{"members": normalize.Email(email)})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []*Group
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// update applies an update document to a single group and returns it after the change.
+func (g *GroupsStore) update(ctx context.Context, id bson.ObjectID, update bson.M) (*Group, error) {
+	return g.updateWhere(ctx, bson.M{"_id": id}, update)
+}
+
+// updateWhere is update for the group matching filter. ErrGroupNotFound means
+// nothing matched.
+func (g *GroupsStore) updateWhere(ctx context.Context, filter, update bson.M) (*Group, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var group Group
+	err := g.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	return &group, nil
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+)
+
+func TestGroupsMembership(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.GroupsCollection().Drop(ctx)
+	_ = c.MessagesCollection().Drop(ctx)
+
+	groups := NewGroupsStore(c.GroupsCollection())
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	// owner is always a member and duplicates/mixed case collapse
+	g, err := groups.CreateGroup(ctx, "team", "Alice@Example.com", []string{"bob@example.com", "BOB@example.com"})
+	if err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	if len(g.Members) != 2 || g.Members[0] != "alice@example.com" {
+		t.Fatalf("unexpected members: %v", g.Members)
+	}
+
+	g, err = groups.AddMember(ctx, g.ID, "carol@example.com")
+	if err != nil {
+		t.Fatalf("AddMember failed: %v", err)
+	}
+	if !g.HasMember("carol@example.com") {
+		t.Fatalf("expected carol to be a member: %v", g.Members)
+	}
+	if len(g.Joins) != 3 || g.Joins[2].Email != "carol@example.com" {
+		t.Fatalf("expected carol's join to be recorded: %+v", g.Joins)
+	}
+	// adding a member again changes nothing
+	if again, err := groups.AddMember(ctx, g.ID, "carol@example.com"); err != nil || len(again.Members) != len(g.Members) {
+		t.Fatalf("second AddMember = %v, %v", again, err)
+	}
+
+	g, err = groups.RemoveMember(ctx, g.ID, "bob@example.com")
+	if err != nil {
+		t.Fatalf("RemoveMember failed: %v", err)
+	}
+	if g.HasMember("bob@example.com") {
+		t.Fatalf("expected bob to be removed: %v", g.Members)
+	}
+
+	mine, err := groups.ListGroupsForUser(ctx, "carol@example.com")
+	if err != nil {
+		t.Fatalf("ListGroupsForUser failed: %v", err)
+	}
+	if len(mine) != 1 || mine[0].ID != g.ID {
+		t.Fatalf("expected carol to see 1 group, got %d", len(mine))
+	}
+
+	// group messages show up in group history
+	now := time.Now()
+	if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", GroupID: g.ID, Content: "hi team", SentAt: now}); err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	history, err := msgs.GetGroupHistory(ctx, g.ID, "alice@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetGroupHistory failed: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected 1 group message, got %d", len(history))
+	}
+}
+
+func TestGroupsInvites(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.GroupsCollection().Drop(ctx)
+	groups := NewGroupsStore(c.GroupsCollection())
+
+	team, err := groups.CreateGroup(ctx, "team", "alice@example.com", nil)
+	if err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	g, created, err := groups.Invite(ctx, team.ID, "Bob@Example.com", "alice@example.com")
+	if err != nil || !created || !g.HasInvite("bob@example.com") || g.HasMember("bob@example.com") {
+		t.Fatalf("Invite = %+v, %v, %v; want bob invited", g, created, err)
+	}
+	// inviting twice, or inviting a member, changes nothing
+	if _, created, err := groups.Invite(ctx, team.ID, "bob@example.com", "alice@example.com"); err != nil || created {
+		t.Fatalf("second Invite = %v, %v; want not created", created, err)
+	}
+	if _, created, err := groups.Invite(ctx, team.ID, "alice@example.com", "alice@example.com"); err != nil || created {
+		t.Fatalf("inviting a member = %v, %v; want not created", created, err)
+	}
+
+	// only invites from the accepted requester are turned into membership
+	other, _ := groups.CreateGroup(ctx, "other", "carol@example.com", nil)
+	_, _, _ = groups.Invite(ctx, other.ID, "bob@example.com", "carol@example.com")
+	joined, err := groups.AcceptInvites(ctx, "bob@example.com", "alice@example.com")
+	if err != nil || joined != 1 {
+		t.Fatalf("AcceptInvites = %d, %v; want 1", joined, err)
+	}
+	g, _ = groups.GetGroup(ctx, team.ID)
+	if !g.HasMember("bob@example.com") || g.HasInvite("bob@example.com") {
+		t.Fatalf("expected bob to have joined team: %+v", g)
+	}
+
+	if err := groups.DropInvites(ctx, "bob@example.com", "carol@example.com"); err != nil {
+		t.Fatalf("DropInvites failed: %v", err)
+	}
+	g, _ = groups.GetGroup(ctx, other.ID)
+	if g.HasInvite("bob@example.com") || g.HasMember("bob@example.com") {
+		t.Fatalf("expected bob's invite to other to be dropped: %+v", g)
+	}
+
+	// removing an invitee withdraws the invite
+	_, _, _ = groups.Invite(ctx, other.ID, "dave@example.com", "carol@example.com")
+	g, err = groups.RemoveMember(ctx, other.ID, "dave@example.com")
+	if err != nil || g.HasInvite("dave@example.com") {
+		t.Fatalf("RemoveMember = %+v, %v; want the invite withdrawn", g, err)
+	}
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
@@ -11,6 +12,17 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// ErrMessageNotFound is returned when a message lookup or update matches nothing.
+var ErrMessageNotFound = errors.New("message not found")
+
+// ErrReactionLimit is returned by React when the user already has the maximum
+// number of different reactions on the message.
+var ErrReactionLimit = errors.New("reaction limit reached")
+
+// ErrDuplicateMessage is returned by InsertMessage when the sender already
+// stored a message with the same client_msg_id.
+var ErrDuplicateMessage = errors.New("duplicate client message id")
+
 // MessagesStore provides message database operations.
 type MessagesStore struct {
 	// coll is reference to "messages" collection in MongoDB
@@ -23,39 +35,405 @@ func NewMessagesStore(coll *mongo.Collection) *MessagesStore {
 	return &MessagesStore{coll: coll} // Store reference to MongoDB collection
 }
 
-// SaveMessage inserts a message document and returns the saved record.
+// SaveMessage inserts a 1-on-1 message document and returns the saved record.
 func (m *MessagesStore) SaveMessage(ctx context.Context, fromEmail, toEmail, content string, sentAt time.Time) (*Message, error) {
 	// Create Message struct matching the domain model in models.go
-	msg := &Message{
-		// Ensure emails are stored in normalized (lowercase + trimmed) form
-		FromEmail: normalize.Email(fromEmail), // Sender email from JWT claims
-		ToEmail:   normalize.Email(toEmail),   // Recipient email from ChatStreamRequest.to_email
-		Content:   content,                    // Message text from ChatStreamRequest.content
-		SentAt:    sentAt,                     // Timestamp when client sent (for ordering)
-		CreatedAt: time.Now(),                 // Server-side timestamp when saved
-	}
+	return m.InsertMessage(ctx, &Message{
+		FromEmail: fromEmail, // Sender email from JWT claims
+		ToEmail:   toEmail,   // Recipient email from OutgoingMessage.to_email
+		Content:   content,   // Message text from OutgoingMessage.content
+		SentAt:    sentAt,    // Timestamp when client sent (for ordering)
+	})
+}
+
+// InsertMessage inserts a fully-populated message (1-on-1 or group) and returns
+// it with its ID set. Emails are normalized and CreatedAt is stamped here.
+func (m *MessagesStore) InsertMessage(ctx context.Context, msg *Message) (*Message, error) {
+	// Ensure emails are stored in normalized (lowercase + trimmed) form
+	msg.FromEmail = normalize.Email(msg.FromEmail)
+	msg.ToEmail = normalize.Email(msg.ToEmail)
+	msg.CreatedAt = time.Now() // Server-side timestamp when saved
 
 	// InsertOne adds the message document to MongoDB collection
 	result, err := m.coll.InsertOne(ctx, msg)
 	if err != nil {
+		// The (from_email, client_msg_id) index rejects retried sends
+		if msg.ClientMsgID != "" && mongo.IsDuplicateKeyError(err) {
+			return nil, ErrDuplicateMessage
+		}
 		return nil, err // Database error (connection, validation, etc)
 	}
 
 	// Extract MongoDB's auto-generated _id and populate in struct
-	// This ID is returned to client in ChatStreamResponse.msg_id
+	// This ID is returned to client in ChatMessage.msg_id
 	msg.ID = result.InsertedID.(bson.ObjectID)
 
 	// Return the saved message with ID; handler broadcasts to stream
 	return msg, nil
 }
 
-// GetMessageHistory returns recent messages between two users (ordered oldest→newest).
-func (m *MessagesStore) GetMessageHistory(ctx context.Context, user1, user2 string, limit int64) ([]*Message, error) {
-	// Set MongoDB Find options: sort by sent_at descending (newest first) and limit results
+// GetMessageByClientID finds the message fromEmail sent with clientMsgID.
+func (m *MessagesStore) GetMessageByClientID(ctx context.Context, fromEmail, clientMsgID string) (*Message, error) {
+	var msg Message
+	err := m.coll.FindOne(ctx, bson.M{
+		"from_email":    normalize.Email(fromEmail),
+		"client_msg_id": clientMsgID,
+	}).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GetMessage finds a message by ObjectID.
+func (m *MessagesStore) GetMessage(ctx context.Context, id bson.ObjectID) (*Message, error) {
+	var msg Message
+	err := m.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// EditMessage replaces the content of a message sent by fromEmail, appending the
+// previous content to its revisions, and returns the updated message.
+func (m *MessagesStore) EditMessage(ctx context.Context, id bson.ObjectID, fromEmail, content string, editedAt time.Time) (*Message, error) {
+	// A pipeline update lets us copy the current content into revisions and
+	// overwrite it in one atomic step
+	update := mongo.Pipeline{
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "revisions", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				bson.D{{Key: "$ifNull", Value: bson.A{"$revisions", bson.A{}}}},
+				bson.A{bson.D{{Key: "content", Value: "$content"}, {Key: "replaced_at", Value: editedAt}}},
+			}}}},
+			{Key: "content", Value: content},
+			{Key: "edited_at", Value: editedAt},
+		}}},
+	}
+
+	// Matching on from_email as well keeps the sender check race-free; tombstones
+	// can no longer be edited
+	filter := bson.M{
+		"_id":        id,
+		"from_email": normalize.Email(fromEmail),
+		"deleted_at": bson.M{"$exists": false},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var msg Message
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// DeleteMessageForEveryone turns a message sent by fromEmail into a tombstone:
+// content is replaced with DeletedContent and edit history and attachments are
+// dropped. Replies quoting the message have their quote snapshot scrubbed too.
+func (m *MessagesStore) DeleteMessageForEveryone(ctx context.Context, id bson.ObjectID, fromEmail string, deletedAt time.Time) (*Message, error) {
+	filter := bson.M{"_id": id, "from_email": normalize.Email(fromEmail)}
+	update := bson.M{
+		"$set":   bson.M{"content": DeletedContent, "deleted_at": deletedAt},
+		"$unset": bson.M{"revisions": "", "edited_at": "", "attachments": ""},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var msg Message
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	_, err = m.coll.UpdateMany(ctx, bson.M{"reply_to.msg_id": id}, bson.M{
+		"$set": bson.M{"reply_to.content": DeletedContent},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// HideMessage hides a message from email's own history and chat list.
+func (m *MessagesStore) HideMessage(ctx context.Context, id bson.ObjectID, email string) error {
+	result, err := m.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$addToSet": bson.M{"hidden_for": normalize.Email(email)},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrMessageNotFound
+	}
+	return nil
+}
+
+// MarkDelivered records that email's client received the message. It returns
+// ErrMessageNotFound if the message doesn't exist, was sent by email, or was
+// already acknowledged by email, so callers only relay the first ack.
+func (m *MessagesStore) MarkDelivered(ctx context.Context, id bson.ObjectID, email string) (*Message, error) {
+	email = normalize.Email(email)
+	filter := bson.M{
+		"_id":          id,
+		"from_email":   bson.M{"$ne": email},
+		"delivered_to": bson.M{"$ne": email},
+	}
+	update := bson.M{"$addToSet": bson.M{"delivered_to": email}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var msg Message
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GetUndelivered returns messages addressed to email, directly or through one
+// of groups, that email has not acknowledged yet (ordered oldest→newest).
+// Group messages sent before email joined the group are left out.
+func (m *MessagesStore) GetUndelivered(ctx context.Context, email string, groups []*Group, limit int64) ([]*Message, error) {
+	email = normalize.Email(email)
+	targets := bson.A{bson.M{"to_email": email}}
+	for _, g := range groups {
+		targets = append(targets, bson.M{"group_id": g.ID, "sent_at": bson.M{"$gte": g.JoinedAt(email)}})
+	}
+	filter := bson.M{
+		"$or":          targets,
+		"from_email":   bson.M{"$ne": email},
+		"delivered_to": bson.M{"$ne": email},
+		"hidden_for":   bson.M{"$ne": email},
+		"expires_at":   unexpired(),
+		// Message requests wait until the recipient accepts them
+		"pending": bson.M{"$ne": true},
+	}
+
+	// Oldest first so clients replay the backlog in order
 	opts := options.Find().
-		SetSort(bson.M{"sent_at": -1}). // -1 means descending order (newest first)
-		SetLimit(limit)                 // Only return N most recent messages
+		SetSort(bson.M{"sent_at": 1}).
+		SetLimit(limit)
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
 
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// BackfillDelivered marks messages stored before delivery acks existed as
+// delivered to their recipients: the recipient of a 1-on-1 message, or every
+// current member of a group but the sender. Without it every reconnect would
+// replay the oldest of them to clients that never ack. Pending requests are
+// left alone; they are delivered once accepted. It returns how many messages
+// were marked.
+func (m *MessagesStore) BackfillDelivered(ctx context.Context) (int64, error) {
+	match := bson.D{
+		{Key: "delivered_to", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "pending", Value: bson.D{{Key: "$ne", Value: true}}},
+	}
+	n, err := m.coll.CountDocuments(ctx, match)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "groups"},
+			{Key: "localField", Value: "group_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "group"},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{{Key: "delivered_to", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{"$group_id", nil}}},
+			bson.D{{Key: "$setDifference", Value: bson.A{
+				bson.D{{Key: "$ifNull", Value: bson.A{bson.D{{Key: "$arrayElemAt", Value: bson.A{"$group.members", 0}}}, bson.A{}}}},
+				bson.A{"$from_email"},
+			}}},
+			bson.A{"$to_email"},
+		}}}}}}},
+		bson.D{{Key: "$merge", Value: bson.D{
+			{Key: "into", Value: m.coll.Name()},
+			{Key: "on", Value: "_id"},
+			{Key: "whenMatched", Value: "merge"},
+			{Key: "whenNotMatched", Value: "discard"},
+		}}},
+	}
+	cursor, err := m.coll.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return 0, err
+	}
+	return n, cursor.Close(ctx)
+}
+
+// GetInboxSince returns messages in any of email's conversations, its 1-on-1
+// chats and groupIDs, inserted after the message with ID after (ordered by _id,
+// which is insertion order). It includes email's own messages so other devices
+// catch up too.
+func (m *MessagesStore) GetInboxSince(ctx context.Context, email string, groupIDs []bson.ObjectID, after bson.ObjectID, limit int64) ([]*Message, error) {
+	email = normalize.Email(email)
+	targets := bson.A{
+		// Message requests wait until the recipient accepts them
+		bson.M{"to_email": email, "pending": bson.M{"$ne": true}},
+		bson.M{"from_email": email, "group_id": bson.M{"$exists": false}},
+	}
+	if len(groupIDs) > 0 {
+		targets = append(targets, bson.M{"group_id": bson.M{"$in": groupIDs}})
+	}
+	filter := bson.M{
+		"$or":        targets,
+		"_id":        bson.M{"$gt": after},
+		"hidden_for": bson.M{"$ne": email},
+		"expires_at": unexpired(),
+	}
+	opts := options.Find().
+		SetSort(bson.M{"_id": 1}).
+		SetLimit(limit)
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// React adds email's emoji reaction to a message. Reacting twice with the same
+// emoji is a no-op; deleted messages can't be reacted to. A user can have at
+// most maxPerUser different reactions on a message: past that, new emojis
+// fail with ErrReactionLimit.
+func (m *MessagesStore) React(ctx context.Context, id bson.ObjectID, email, emoji string, maxPerUser int) (*Message, error) {
+	email = normalize.Email(email)
+	// The limit is part of the filter so concurrent reactions can't overshoot it
+	own := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$reactions", bson.A{}}},
+		"cond":  bson.M{"$eq": bson.A{"$$this.email", email}},
+	}}
+	filter := bson.M{
+		"_id":        id,
+		"deleted_at": bson.M{"$exists": false},
+		"$or": bson.A{
+			bson.M{"reactions": bson.M{"$elemMatch": bson.M{"email": email, "emoji": emoji}}},
+			bson.M{"$expr": bson.M{"$lt": bson.A{bson.M{"$size": own}, maxPerUser}}},
+		},
+	}
+	// $addToSet on the whole subdocument keeps one reaction per (user, emoji)
+	update := bson.M{"$addToSet": bson.M{"reactions": Reaction{Email: email, Emoji: emoji}}}
+	msg, err := m.updateReactions(ctx, filter, update)
+	if err != ErrMessageNotFound {
+		return msg, err
+	}
+
+	// Tell a missing or deleted message apart from a full one
+	msg, err = m.GetMessage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !msg.DeletedAt.IsZero() {
+		return nil, ErrMessageNotFound
+	}
+	return nil, ErrReactionLimit
+}
+
+// Unreact removes email's emoji reaction from a message, if present.
+func (m *MessagesStore) Unreact(ctx context.Context, id bson.ObjectID, email, emoji string) (*Message, error) {
+	update := bson.M{"$pull": bson.M{"reactions": bson.M{"email": normalize.Email(email), "emoji": emoji}}}
+	return m.updateReactions(ctx, bson.M{"_id": id}, update)
+}
+
+// updateReactions applies a reactions update and returns the updated message.
+func (m *MessagesStore) updateReactions(ctx context.Context, filter, update bson.M) (*Message, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var msg Message
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GetThread returns a thread's root message followed by every reply to it
+// (ordered oldest→newest), leaving out messages the viewer deleted for themselves
+// and requests they haven't accepted.
+func (m *MessagesStore) GetThread(ctx context.Context, rootID bson.ObjectID, viewer string, limit int64) ([]*Message, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"_id": rootID},
+			bson.M{"thread_root_id": rootID},
+		},
+		"hidden_for": bson.M{"$ne": normalize.Email(viewer)},
+		"expires_at": unexpired(),
+		"$nor":       bson.A{pendingFor(normalize.Email(viewer))},
+	}
+	opts := options.Find().
+		SetSort(bson.M{"sent_at": 1}).
+		SetLimit(limit)
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// GetMessagesWithAttachment returns up to limit messages carrying the given
+// attachment; used to authorize downloads.
+func (m *MessagesStore) GetMessagesWithAttachment(ctx context.Context, attachmentID bson.ObjectID, limit int64) ([]*Message, error) {
+	opts := options.Find().SetLimit(limit)
+	cursor, err := m.coll.Find(ctx, bson.M{"attachments.attachment_id": attachmentID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// GetMessageHistory returns a page of messages between two users (ordered oldest→newest).
+// user1 is the viewer: messages they deleted for themselves and requests they
+// haven't accepted are left out.
+// A nil cursor returns the most recent page.
+func (m *MessagesStore) GetMessageHistory(ctx context.Context, user1, user2 string, cursor *HistoryCursor, limit int64) ([]*Message, error) {
 	// Create filter to match messages between these two users (bidirectional)
 	// "$or" means either condition is true
 	// Normalize the provided emails before building the query so mixed-case
@@ -64,6 +442,12 @@ func (m *MessagesStore) GetMessageHistory(ctx context.Context, user1, user2 stri
 	u2 := normalize.Email(user2)
 
 	filter := bson.M{
+		// Skip messages the viewer deleted for themselves
+		"hidden_for": bson.M{"$ne": u1},
+		// and disappearing messages that have expired
+		"expires_at": unexpired(),
+		// and requests the viewer hasn't accepted yet
+		"$nor": bson.A{pendingFor(u1)},
 		"$or": bson.A{
 			bson.M{
 				// Messages sent FROM user1 TO user2
@@ -78,117 +462,250 @@ func (m *MessagesStore) GetMessageHistory(ctx context.Context, user1, user2 stri
 		},
 	}
 
+	return m.findPage(ctx, filter, cursor, limit)
+}
+
+// unexpired matches messages that have no expiry or whose expiry is still
+// ahead, hiding disappearing messages the TTL monitor hasn't removed yet.
+func unexpired() bson.M {
+	return bson.M{"$not": bson.M{"$lte": time.Now()}}
+}
+
+// pendingFor matches the message requests sent to email that they haven't
+// accepted yet; their senders still see them.
+func pendingFor(email string) bson.M {
+	return bson.M{"to_email": email, "pending": true}
+}
+
+// HistoryCursor positions a history page next to a known message using the
+// (sent_at, _id) keyset, so pages stay stable while new messages arrive.
+type HistoryCursor struct {
+	SentAt time.Time
+	ID     bson.ObjectID
+	// After selects messages newer than the cursor instead of older ones
+	After bool
+}
+
+// CursorAt returns a cursor positioned at msg.
+func CursorAt(msg *Message, after bool) *HistoryCursor {
+	return &HistoryCursor{SentAt: msg.SentAt, ID: msg.ID, After: after}
+}
+
+// keyset restricts filter to messages strictly before (or after) cursor.
+func keyset(filter bson.M, cursor *HistoryCursor) bson.M {
+	if cursor == nil {
+		return filter
+	}
+	op := "$lt"
+	if cursor.After {
+		op = "$gt"
+	}
+	return bson.M{"$and": bson.A{filter, bson.M{"$or": bson.A{
+		bson.M{"sent_at": bson.M{op: cursor.SentAt}},
+		bson.M{"sent_at": cursor.SentAt, "_id": bson.M{op: cursor.ID}},
+	}}}}
+}
+
+// findPage runs filter restricted to the limit messages nearest to cursor and
+// returns them oldest→newest.
+func (m *MessagesStore) findPage(ctx context.Context, filter bson.M, cursor *HistoryCursor, limit int64) ([]*Message, error) {
+	// Pages before the cursor (and the latest page) are read newest first so the
+	// limit keeps the messages closest to the cursor; _id breaks sent_at ties
+	dir := -1
+	if cursor != nil && cursor.After {
+		dir = 1
+	}
+	filter = keyset(filter, cursor)
+	opts := options.Find().
+		SetSort(bson.D{{Key: "sent_at", Value: dir}, {Key: "_id", Value: dir}}).
+		SetLimit(limit)
+
 	// Execute the query; Find returns a cursor to iterate results
-	cursor, err := m.coll.Find(ctx, filter, opts)
+	results, err := m.coll.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err // Database error
 	}
 	// Ensure cursor is closed when done (cleanup)
-	defer cursor.Close(ctx)
+	defer results.Close(ctx)
 
 	// Initialize slice to hold decoded messages
 	var messages []*Message
 
 	// All() reads all documents from cursor and decodes into messages slice
-	if err = cursor.All(ctx, &messages); err != nil {
+	if err = results.All(ctx, &messages); err != nil {
 		return nil, err // Error decoding documents
 	}
 
-	// Reverse the slice because MongoDB returned newest first (-1 sort)
+	// Reverse the slice when MongoDB returned newest first (-1 sort)
 	// But client expects chronological order: oldest message first
-	// Classic two-pointer swap: i starts at 0, j starts at end
-	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
-		// Swap elements at positions i and j
-		messages[i], messages[j] = messages[j], messages[i]
+	if dir < 0 {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
 	}
 
 	// Return chronologically ordered messages (oldest first, newest last)
 	return messages, nil
 }
 
-// GetRecentChats aggregates recent partners and last message info.
-func (m *MessagesStore) GetRecentChats(ctx context.Context, userEmail string, limit int64) ([]*ChatPartner, error) {
-	// MongoDB Aggregation Pipeline: series of stages that transform data
-	// Think of it like: filter → group → sort → limit
-	// Normalize the user email first so the pipeline matches stored documents
-	userEmail = normalize.Email(userEmail)
+// GetGroupHistory returns a page of messages posted to a group (ordered oldest→newest),
+// leaving out messages the viewer deleted for themselves.
+func (m *MessagesStore) GetGroupHistory(ctx context.Context, groupID bson.ObjectID, viewer string, cursor *HistoryCursor, limit int64) ([]*Message, error) {
+	filter := bson.M{
+		"group_id":   groupID,
+		"hidden_for": bson.M{"$ne": normalize.Email(viewer)},
+		"expires_at": unexpired(),
+	}
+	return m.findPage(ctx, filter, cursor, limit)
+}
+
+// CountReceived counts messages partner sent to owner after after and at or
+// before upTo, leaving out requests owner hasn't accepted; MarkRead uses it to
+// settle unread counts.
+func (m *MessagesStore) CountReceived(ctx context.Context, owner, partner string, after, upTo time.Time) (int64, error) {
+	return m.coll.CountDocuments(ctx, bson.M{
+		"from_email": normalize.Email(partner),
+		"to_email":   normalize.Email(owner),
+		"sent_at":    bson.M{"$gt": after, "$lte": upTo},
+		"pending":    bson.M{"$ne": true},
+	})
+}
 
+// CountGroupReceived counts messages others posted to a group after after and
+// at or before upTo.
+func (m *MessagesStore) CountGroupReceived(ctx context.Context, owner string, groupID bson.ObjectID, after, upTo time.Time) (int64, error) {
+	return m.coll.CountDocuments(ctx, bson.M{
+		"group_id":   groupID,
+		"from_email": bson.M{"$ne": normalize.Email(owner)},
+		"sent_at":    bson.M{"$gt": after, "$lte": upTo},
+		"pending":    bson.M{"$ne": true},
+	})
+}
+
+// ConversationSummaries calls fn with a summary of every conversation that
+// has messages, one per participant, built from the newest message that
+// participant can still see. Participants of a group are its current members,
+// read from the "groups" collection. It rebuilds the conversations collection
+// for chats that predate it.
+func (m *MessagesStore) ConversationSummaries(ctx context.Context, fn func(*Conversation) error) error {
 	pipeline := mongo.Pipeline{
-		// Stage 1: $match - Filter messages where userEmail appears as sender or recipient
-		bson.D{{Key: "$match", Value: bson.D{
-			{Key: "$or", Value: bson.A{
-				// Messages sent BY this user
-				bson.D{{Key: "from_email", Value: userEmail}},
-				// Messages sent TO this user
-				bson.D{{Key: "to_email", Value: userEmail}},
-			}},
+		// Disappearing messages past their expiry no longer count as activity
+		bson.D{{Key: "$match", Value: bson.D{{Key: "expires_at", Value: unexpired()}}}},
+
+		bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "groups"},
+			{Key: "localField", Value: "group_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "group"},
 		}}},
 
-		// Stage 2: $group - Group messages by conversation partner
-		// For each unique partner, collect the last message
+		// Every participant who still sees the message owns a copy of it: group
+		// members, or the sender and (unless the message is a pending request)
+		// the recipient, minus whoever deleted it for themselves
+		bson.D{{Key: "$set", Value: bson.D{{Key: "owner_email", Value: bson.D{{Key: "$setDifference", Value: bson.A{
+			bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$gt", Value: bson.A{"$group_id", nil}}},
+				bson.D{{Key: "$ifNull", Value: bson.A{bson.D{{Key: "$arrayElemAt", Value: bson.A{"$group.members", 0}}}, bson.A{}}}},
+				bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$eq", Value: bson.A{"$pending", true}}},
+					bson.A{"$from_email"},
+					bson.A{"$from_email", "$to_email"},
+				}}},
+			}}},
+			bson.D{{Key: "$ifNull", Value: bson.A{"$hidden_for", bson.A{}}}},
+		}}}}}}},
+		bson.D{{Key: "$unwind", Value: "$owner_email"}},
+
+		// Oldest first so $last below picks each conversation's newest message
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "sent_at", Value: 1}, {Key: "_id", Value: 1}}}},
+
 		bson.D{{Key: "$group", Value: bson.D{
-			// _id: how to identify each group (by partner email)
 			{Key: "_id", Value: bson.D{
-				// partner: determine who the partner is
-				{Key: "partner", Value: bson.D{
-					// $cond: if from_email == userEmail, then partner is to_email, else from_email
-					// This handles both directions of conversation
-					{Key: "$cond", Value: bson.A{
-						bson.D{{Key: "$eq", Value: bson.A{"$from_email", userEmail}}},
-						"$to_email",   // If true, partner is recipient
-						"$from_email", // If false, partner is sender
-					}},
-				}},
+				{Key: "owner_email", Value: "$owner_email"},
+				{Key: "group_id", Value: "$group_id"},
+				// The partner of a 1-on-1 chat is whoever the owner isn't
+				{Key: "partner_email", Value: bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$gt", Value: bson.A{"$group_id", nil}}},
+					"$$REMOVE",
+					bson.D{{Key: "$cond", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$from_email", "$owner_email"}}},
+						"$to_email",
+						"$from_email",
+					}}},
+				}}}},
 			}},
-			// Accumulate the last message content in each group
+			{Key: "last_msg_id", Value: bson.D{{Key: "$last", Value: "$_id"}}},
 			{Key: "last_message", Value: bson.D{{Key: "$last", Value: "$content"}}},
-			// Accumulate the last message timestamp in each group
+			{Key: "last_from_email", Value: bson.D{{Key: "$last", Value: "$from_email"}}},
 			{Key: "last_message_at", Value: bson.D{{Key: "$last", Value: "$sent_at"}}},
+			{Key: "last_expires_at", Value: bson.D{{Key: "$last", Value: "$expires_at"}}},
 		}}},
 
-		// Stage 3: $sort - Sort by most recent conversation first
-		bson.D{{Key: "$sort", Value: bson.D{{Key: "last_message_at", Value: -1}}}},
-
-		// Stage 4: $limit - Only return N most recent partners
-		bson.D{{Key: "$limit", Value: limit}},
+		// Flatten into the shape of a conversation document
+		bson.D{{Key: "$replaceWith", Value: bson.D{{Key: "$mergeObjects", Value: bson.A{
+			"$_id",
+			bson.D{
+				{Key: "last_msg_id", Value: "$last_msg_id"},
+				{Key: "last_message", Value: "$last_message"},
+				{Key: "last_from_email", Value: "$last_from_email"},
+				{Key: "last_message_at", Value: "$last_message_at"},
+				{Key: "last_expires_at", Value: "$last_expires_at"},
+			},
+		}}}}},
 	}
 
-	// Execute aggregation pipeline; Aggregate returns cursor
-	cursor, err := m.coll.Aggregate(ctx, pipeline)
+	// The sort spans the whole collection, so let it spill to disk
+	cursor, err := m.coll.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
-		return nil, err // Pipeline execution error
+		return err
 	}
-	// Ensure cursor is closed (cleanup)
 	defer cursor.Close(ctx)
 
-	// Initialize slice to hold raw aggregation results (BSON documents)
-	var results []bson.M
-
-	// All() reads all aggregation results into the slice
-	if err = cursor.All(ctx, &results); err != nil {
-		return nil, err // Error decoding results
+	for cursor.Next(ctx) {
+		var conv Conversation
+		if err := cursor.Decode(&conv); err != nil {
+			return err
+		}
+		if err := fn(&conv); err != nil {
+			return err
+		}
 	}
+	return cursor.Err()
+}
 
-	// Initialize slice for ChatPartner structs
-	var partners []*ChatPartner
-
-	// Convert raw BSON results into ChatPartner structs
-	for _, result := range results {
-		// Extract partner email from nested _id.partner field
-		partner := &ChatPartner{
-			// result["_id"] is a bson.M (map) with key "partner"
-			Email: result["_id"].(bson.M)["partner"].(string),
+// GetPending returns up to limit pending messages from fromEmail to toEmail,
+// oldest first, starting after the after cursor when one is given.
+func (m *MessagesStore) GetPending(ctx context.Context, fromEmail, toEmail string, after *HistoryCursor, limit int64) ([]*Message, error) {
+	filter := keyset(bson.M{
+		"from_email": normalize.Email(fromEmail),
+		"to_email":   normalize.Email(toEmail),
+		"pending":    true,
+		"expires_at": unexpired(),
+	}, after)
+	opts := options.Find().
+		SetSort(bson.D{{Key: "sent_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
 
-			// Extract last message text
-			LastMessage: result["last_message"].(string),
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
 
-			// Extract last message timestamp
-			LastMessageTime: result["last_message_at"].(time.Time),
-		}
-		// Append to partners slice
-		partners = append(partners, partner)
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
 	}
+	return messages, nil
+}
 
-	// Return all chat partners sorted by most recent conversation
-	return partners, nil
+// ClearPending makes every pending message from fromEmail to toEmail a regular
+// message once toEmail accepted the request.
+func (m *MessagesStore) ClearPending(ctx context.Context, fromEmail, toEmail string) error {
+	_, err := m.coll.UpdateMany(ctx, bson.M{
+		"from_email": normalize.Email(fromEmail),
+		"to_email":   normalize.Email(toEmail),
+		"pending":    true,
+	}, bson.M{"$unset": bson.M{"pending": ""}})
+	return err
 }
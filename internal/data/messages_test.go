@@ -2,11 +2,14 @@ package data
 
 import (
 	"context"
+	"errors"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 func TestMessagesSaveAndQuery(t *testing.T) {
@@ -40,22 +43,13 @@ func TestMessagesSaveAndQuery(t *testing.T) {
 	}
 
 	// history
-	history, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", 10)
+	history, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", nil, 10)
 	if err != nil {
 		t.Fatalf("GetMessageHistory failed: %v", err)
 	}
 	if len(history) < 2 {
 		t.Fatalf("expected >=2 messages, got %d", len(history))
 	}
-
-	// recent chats
-	partners, err := msgs.GetRecentChats(ctx, "alice@example.com", 10)
-	if err != nil {
-		t.Fatalf("GetRecentChats failed: %v", err)
-	}
-	if len(partners) == 0 {
-		t.Fatalf("expected at least 1 partner")
-	}
 }
 
 func TestMessagesNormalization(t *testing.T) {
@@ -86,20 +80,600 @@ func TestMessagesNormalization(t *testing.T) {
 	}
 
 	// history query using different casing should still return the message
-	history, err := msgs.GetMessageHistory(ctx, "alice@example.com", "BOB@example.COM", 10)
+	history, err := msgs.GetMessageHistory(ctx, "alice@example.com", "BOB@example.COM", nil, 10)
 	if err != nil {
 		t.Fatalf("GetMessageHistory failed: %v", err)
 	}
 	if len(history) < 1 {
 		t.Fatalf("expected >=1 messages, got %d", len(history))
 	}
+}
+
+func TestMessagesEdit(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.MessagesCollection().Drop(ctx)
+
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	saved, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "helo", time.Now())
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+
+	// only the sender may edit
+	if _, err := msgs.EditMessage(ctx, saved.ID, "bob@example.com", "hijack", time.Now()); err != ErrMessageNotFound {
+		t.Fatalf("expected ErrMessageNotFound for non-sender edit, got %v", err)
+	}
+
+	edited, err := msgs.EditMessage(ctx, saved.ID, "ALICE@example.com", "hello", time.Now())
+	if err != nil {
+		t.Fatalf("EditMessage failed: %v", err)
+	}
+	if edited.Content != "hello" || edited.EditedAt.IsZero() {
+		t.Fatalf("unexpected edited message: %+v", edited)
+	}
+	if len(edited.Revisions) != 1 || edited.Revisions[0].Content != "helo" {
+		t.Fatalf("expected previous content in revisions, got %+v", edited.Revisions)
+	}
+
+	got, err := msgs.GetMessage(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if got.Content != "hello" {
+		t.Fatalf("GetMessage returned stale content: %s", got.Content)
+	}
+}
+
+func TestMessagesDelete(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.MessagesCollection().Drop(ctx)
+
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	first, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "oops", now)
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+	second, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "hi", now.Add(time.Second))
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+
+	// delete for me hides only from the caller
+	if err := msgs.HideMessage(ctx, second.ID, "BOB@example.com"); err != nil {
+		t.Fatalf("HideMessage failed: %v", err)
+	}
+	bobView, err := msgs.GetMessageHistory(ctx, "bob@example.com", "alice@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetMessageHistory failed: %v", err)
+	}
+	if len(bobView) != 1 || bobView[0].ID != first.ID {
+		t.Fatalf("expected hidden message to be filtered for bob, got %d messages", len(bobView))
+	}
+	aliceView, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetMessageHistory failed: %v", err)
+	}
+	if len(aliceView) != 2 {
+		t.Fatalf("expected alice to still see both messages, got %d", len(aliceView))
+	}
+
+	// only the sender may delete for everyone
+	if _, err := msgs.DeleteMessageForEveryone(ctx, first.ID, "bob@example.com", time.Now()); err != ErrMessageNotFound {
+		t.Fatalf("expected ErrMessageNotFound for non-sender delete, got %v", err)
+	}
+	deleted, err := msgs.DeleteMessageForEveryone(ctx, first.ID, "alice@example.com", time.Now())
+	if err != nil {
+		t.Fatalf("DeleteMessageForEveryone failed: %v", err)
+	}
+	if deleted.Content != DeletedContent || deleted.DeletedAt.IsZero() {
+		t.Fatalf("expected tombstone, got %+v", deleted)
+	}
+
+	// tombstones cannot be edited
+	if _, err := msgs.EditMessage(ctx, first.ID, "alice@example.com", "restored", time.Now()); err != ErrMessageNotFound {
+		t.Fatalf("expected ErrMessageNotFound editing a tombstone, got %v", err)
+	}
+
+	// tombstones drop their attachments, so they no longer authorize downloads
+	attID := bson.NewObjectID()
+	withFile, err := msgs.InsertMessage(ctx, &Message{
+		FromEmail:   "alice@example.com",
+		ToEmail:     "bob@example.com",
+		SentAt:      now.Add(2 * time.Second),
+		Attachments: []AttachmentRef{{ID: attID, FileName: "a.txt"}},
+	})
+	if err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	if _, err := msgs.DeleteMessageForEveryone(ctx, withFile.ID, "alice@example.com", time.Now()); err != nil {
+		t.Fatalf("DeleteMessageForEveryone failed: %v", err)
+	}
+	carrying, err := msgs.GetMessagesWithAttachment(ctx, attID, 10)
+	if err != nil {
+		t.Fatalf("GetMessagesWithAttachment failed: %v", err)
+	}
+	if len(carrying) != 0 {
+		t.Fatalf("expected no messages carrying a deleted attachment, got %d", len(carrying))
+	}
+}
+
+func TestMessagesDelivery(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.MessagesCollection().Drop(ctx)
+
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	first, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "one", now)
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+	if _, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "two", now.Add(time.Second)); err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+
+	pending, err := msgs.GetUndelivered(ctx, "bob@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetUndelivered failed: %v", err)
+	}
+	if len(pending) != 2 || pending[0].Content != "one" {
+		t.Fatalf("expected both messages oldest first, got %d", len(pending))
+	}
+
+	// the sender can't ack their own message
+	if _, err := msgs.MarkDelivered(ctx, first.ID, "alice@example.com"); err != ErrMessageNotFound {
+		t.Fatalf("expected ErrMessageNotFound for sender ack, got %v", err)
+	}
+	delivered, err := msgs.MarkDelivered(ctx, first.ID, "bob@example.com")
+	if err != nil {
+		t.Fatalf("MarkDelivered failed: %v", err)
+	}
+	if len(delivered.DeliveredTo) != 1 || delivered.DeliveredTo[0] != "bob@example.com" {
+		t.Fatalf("unexpected delivered_to: %v", delivered.DeliveredTo)
+	}
+	// a repeated ack is reported so it isn't relayed twice
+	if _, err := msgs.MarkDelivered(ctx, first.ID, "bob@example.com"); err != ErrMessageNotFound {
+		t.Fatalf("expected ErrMessageNotFound for duplicate ack, got %v", err)
+	}
+
+	pending, err = msgs.GetUndelivered(ctx, "bob@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetUndelivered failed: %v", err)
+	}
+	if len(pending) != 1 || pending[0].Content != "two" {
+		t.Fatalf("expected only the unacked message, got %d", len(pending))
+	}
+
+	// group messages from before a member joined aren't redelivered to them
+	group := &Group{ID: bson.NewObjectID(), CreatedAt: now.Add(-time.Hour), Joins: []GroupJoin{{Email: "bob@example.com", JoinedAt: now}}}
+	for i, content := range []string{"before", "after"} {
+		sentAt := now.Add(time.Duration(2*i-1) * time.Minute)
+		if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", GroupID: group.ID, Content: content, SentAt: sentAt}); err != nil {
+			t.Fatalf("InsertMessage failed: %v", err)
+		}
+	}
+	pending, err = msgs.GetUndelivered(ctx, "bob@example.com", []*Group{group}, 10)
+	if err != nil || len(pending) != 2 || pending[1].Content != "after" {
+		t.Fatalf("expected only the group message after bob joined, got %+v, %v", pending, err)
+	}
+}
+
+func TestMessagesBackfillDelivered(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.MessagesCollection().Drop(ctx)
+	_ = c.GroupsCollection().Drop(ctx)
+	msgs := NewMessagesStore(c.MessagesCollection())
+	groups := NewGroupsStore(c.GroupsCollection())
+
+	now := time.Now()
+	team, err := groups.CreateGroup(ctx, "team", "alice@example.com", []string{"bob@example.com", "carol@example.com"})
+	if err != nil {
+		t.Fatalf("CreateGroup failed: %v", err)
+	}
+	direct, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "old", SentAt: now})
+	grouped, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", GroupID: team.ID, Content: "old team", SentAt: now})
+	request, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "dave@example.com", ToEmail: "bob@example.com", Content: "hi", SentAt: now, Pending: true})
+
+	n, err := msgs.BackfillDelivered(ctx)
+	if err != nil || n != 2 {
+		t.Fatalf("BackfillDelivered = %d, %v; want 2", n, err)
+	}
+	if m, _ := msgs.GetMessage(ctx, direct.ID); len(m.DeliveredTo) != 1 || m.DeliveredTo[0] != "bob@example.com" {
+		t.Fatalf("unexpected delivered_to of a 1-on-1 message: %v", m.DeliveredTo)
+	}
+	if m, _ := msgs.GetMessage(ctx, grouped.ID); len(m.DeliveredTo) != 2 || slices.Contains(m.DeliveredTo, "alice@example.com") {
+		t.Fatalf("unexpected delivered_to of a group message: %v", m.DeliveredTo)
+	}
+	if m, _ := msgs.GetMessage(ctx, request.ID); len(m.DeliveredTo) != 0 {
+		t.Fatalf("expected a pending request to stay undelivered, got %v", m.DeliveredTo)
+	}
+
+	// running it again finds nothing left
+	if n, err := msgs.BackfillDelivered(ctx); err != nil || n != 0 {
+		t.Fatalf("second BackfillDelivered = %d, %v; want 0", n, err)
+	}
+}
+
+func TestMessagesReactions(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.MessagesCollection().Drop(ctx)
+
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	saved, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "ship it?", time.Now())
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+
+	// reacting twice with the same emoji keeps a single reaction
+	for range 2 {
+		if _, err := msgs.React(ctx, saved.ID, "BOB@example.com", "👍", 2); err != nil {
+			t.Fatalf("React failed: %v", err)
+		}
+	}
+	reacted, err := msgs.React(ctx, saved.ID, "alice@example.com", "👍", 2)
+	if err != nil {
+		t.Fatalf("React failed: %v", err)
+	}
+	if len(reacted.Reactions) != 2 {
+		t.Fatalf("expected 2 reactions, got %+v", reacted.Reactions)
+	}
+
+	// bob's second emoji fits, a third doesn't, but repeating one still does
+	if _, err := msgs.React(ctx, saved.ID, "bob@example.com", "🎉", 2); err != nil {
+		t.Fatalf("React failed: %v", err)
+	}
+	if _, err := msgs.React(ctx, saved.ID, "bob@example.com", "🚀", 2); err != ErrReactionLimit {
+		t.Fatalf("React past the limit: got %v, want ErrReactionLimit", err)
+	}
+	if _, err := msgs.React(ctx, saved.ID, "bob@example.com", "🎉", 2); err != nil {
+		t.Fatalf("repeating a reaction at the limit failed: %v", err)
+	}
+	if _, err := msgs.Unreact(ctx, saved.ID, "bob@example.com", "🎉"); err != nil {
+		t.Fatalf("Unreact failed: %v", err)
+	}
+
+	unreacted, err := msgs.Unreact(ctx, saved.ID, "bob@example.com", "👍")
+	if err != nil {
+		t.Fatalf("Unreact failed: %v", err)
+	}
+	if len(unreacted.Reactions) != 1 || unreacted.Reactions[0].Email != "alice@example.com" {
+		t.Fatalf("unexpected reactions after unreact: %+v", unreacted.Reactions)
+	}
+}
+
+func TestMessagesThread(t *testing.T) {
+	// require MONGODB_URI set externally for integration tests
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// ensure clean collections
+	_ = c.MessagesCollection().Drop(ctx)
+
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	root, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "lunch?", now)
+	if err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+	reply, err := msgs.InsertMessage(ctx, &Message{
+		FromEmail:    "bob@example.com",
+		ToEmail:      "alice@example.com",
+		Content:      "sure",
+		SentAt:       now.Add(time.Second),
+		ReplyTo:      &QuotedMessage{MsgID: root.ID, FromEmail: root.FromEmail, Content: root.Content},
+		ThreadRootID: root.ThreadRoot(),
+	})
+	if err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	// a reply to a reply stays in the root's thread
+	if _, err := msgs.InsertMessage(ctx, &Message{
+		FromEmail:    "alice@example.com",
+		ToEmail:      "bob@example.com",
+		Content:      "noon",
+		SentAt:       now.Add(2 * time.Second),
+		ReplyTo:      &QuotedMessage{MsgID: reply.ID, FromEmail: reply.FromEmail, Content: reply.Content},
+		ThreadRootID: reply.ThreadRoot(),
+	}); err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	if _, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", "unrelated", now.Add(3*time.Second)); err != nil {
+		t.Fatalf("SaveMessage failed: %v", err)
+	}
+
+	thread, err := msgs.GetThread(ctx, root.ID, "alice@example.com", 10)
+	if err != nil {
+		t.Fatalf("GetThread failed: %v", err)
+	}
+	if len(thread) != 3 || thread[0].ID != root.ID || thread[2].Content != "noon" {
+		t.Fatalf("unexpected thread: %d messages", len(thread))
+	}
+	if thread[1].ReplyTo == nil || thread[1].ReplyTo.Content != "lunch?" {
+		t.Fatalf("expected quote snapshot on reply, got %+v", thread[1].ReplyTo)
+	}
+
+	// deleting the root for everyone scrubs the quote held by its reply
+	if _, err := msgs.DeleteMessageForEveryone(ctx, root.ID, "alice@example.com", time.Now()); err != nil {
+		t.Fatalf("DeleteMessageForEveryone failed: %v", err)
+	}
+	scrubbed, err := msgs.GetMessage(ctx, reply.ID)
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if scrubbed.ReplyTo == nil || scrubbed.ReplyTo.Content != DeletedContent {
+		t.Fatalf("expected scrubbed quote on reply, got %+v", scrubbed.ReplyTo)
+	}
+	if scrubbed.Content != "sure" {
+		t.Fatalf("expected reply content untouched, got %q", scrubbed.Content)
+	}
+}
+
+func TestMessagesHistoryPagination(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.MessagesCollection().Drop(ctx)
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	// five messages, the last two sharing a timestamp so _id has to break the tie
+	now := time.Now().Truncate(time.Millisecond)
+	var sent []*Message
+	for i, at := range []time.Time{now, now.Add(time.Second), now.Add(2 * time.Second), now.Add(3 * time.Second), now.Add(3 * time.Second)} {
+		m, err := msgs.SaveMessage(ctx, "alice@example.com", "bob@example.com", string(rune('a'+i)), at)
+		if err != nil {
+			t.Fatalf("SaveMessage failed: %v", err)
+		}
+		sent = append(sent, m)
+	}
+
+	latest, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", nil, 2)
+	if err != nil {
+		t.Fatalf("GetMessageHistory failed: %v", err)
+	}
+	if len(latest) != 2 || latest[0].ID != sent[3].ID || latest[1].ID != sent[4].ID {
+		t.Fatalf("unexpected latest page: %+v", latest)
+	}
+
+	older, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", CursorAt(latest[0], false), 2)
+	if err != nil {
+		t.Fatalf("GetMessageHistory before failed: %v", err)
+	}
+	if len(older) != 2 || older[0].ID != sent[1].ID || older[1].ID != sent[2].ID {
+		t.Fatalf("unexpected older page: %+v", older)
+	}
+
+	newer, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", CursorAt(sent[2], true), 10)
+	if err != nil {
+		t.Fatalf("GetMessageHistory after failed: %v", err)
+	}
+	if len(newer) != 2 || newer[0].ID != sent[3].ID || newer[1].ID != sent[4].ID {
+		t.Fatalf("unexpected newer page: %+v", newer)
+	}
+}
+
+func TestMessagesInboxSince(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.MessagesCollection().Drop(ctx)
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	groupID := bson.NewObjectID()
+	seed := []*Message{
+		{FromEmail: "bob@example.com", ToEmail: "alice@example.com", Content: "seen", SentAt: now},
+		{FromEmail: "bob@example.com", ToEmail: "alice@example.com", Content: "missed", SentAt: now},
+		{FromEmail: "alice@example.com", ToEmail: "carol@example.com", Content: "from another device", SentAt: now},
+		{FromEmail: "carol@example.com", GroupID: groupID, Content: "group", SentAt: now},
+		{FromEmail: "carol@example.com", GroupID: bson.NewObjectID(), Content: "not alice's group", SentAt: now},
+		{FromEmail: "bob@example.com", ToEmail: "dave@example.com", Content: "not alice's chat", SentAt: now},
+	}
+	for _, m := range seed {
+		if _, err := msgs.InsertMessage(ctx, m); err != nil {
+			t.Fatalf("InsertMessage failed: %v", err)
+		}
+	}
+
+	missed, err := msgs.GetInboxSince(ctx, "alice@example.com", []bson.ObjectID{groupID}, seed[0].ID, 10)
+	if err != nil {
+		t.Fatalf("GetInboxSince failed: %v", err)
+	}
+	if len(missed) != 3 || missed[0].ID != seed[1].ID || missed[1].ID != seed[2].ID || missed[2].ID != seed[3].ID {
+		t.Fatalf("unexpected missed messages: %+v", missed)
+	}
+
+	// pages continue from the last ID returned
+	page, err := msgs.GetInboxSince(ctx, "alice@example.com", []bson.ObjectID{groupID}, seed[0].ID, 1)
+	if err != nil {
+		t.Fatalf("GetInboxSince failed: %v", err)
+	}
+	next, err := msgs.GetInboxSince(ctx, "alice@example.com", []bson.ObjectID{groupID}, page[0].ID, 1)
+	if err != nil {
+		t.Fatalf("GetInboxSince failed: %v", err)
+	}
+	if len(next) != 1 || next[0].ID != seed[2].ID {
+		t.Fatalf("unexpected second page: %+v", next)
+	}
+}
 
-	// recent chats for ALICE should show partner bob (normalized)
-	partners, err := msgs.GetRecentChats(ctx, "ALICE@example.com", 10)
+func TestMessagesClientMsgID(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
 	if err != nil {
-		t.Fatalf("GetRecentChats failed: %v", err)
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// the unique (from_email, client_msg_id) index does the deduping
+	_ = c.MessagesCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	original, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "hi", SentAt: time.Now(), ClientMsgID: "c1"})
+	if err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	_, err = msgs.InsertMessage(ctx, &Message{FromEmail: "ALICE@example.com", ToEmail: "bob@example.com", Content: "hi", SentAt: time.Now(), ClientMsgID: "c1"})
+	if !errors.Is(err, ErrDuplicateMessage) {
+		t.Fatalf("expected ErrDuplicateMessage on retry, got %v", err)
+	}
+	found, err := msgs.GetMessageByClientID(ctx, "alice@example.com", "c1")
+	if err != nil {
+		t.Fatalf("GetMessageByClientID failed: %v", err)
+	}
+	if found.ID != original.ID {
+		t.Fatalf("expected the original message, got %s", found.ID.Hex())
+	}
+
+	// other senders and messages without a client ID are unaffected
+	if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "bob@example.com", ToEmail: "alice@example.com", Content: "hi", SentAt: time.Now(), ClientMsgID: "c1"}); err != nil {
+		t.Fatalf("InsertMessage for another sender failed: %v", err)
+	}
+	for range 2 {
+		if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "no id", SentAt: time.Now()}); err != nil {
+			t.Fatalf("InsertMessage without client ID failed: %v", err)
+		}
+	}
+}
+
+func TestMessagesExpiry(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.MessagesCollection().Drop(ctx)
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	// the TTL monitor runs about once a minute, so the expired message is
+	// still stored and has to be filtered out by the queries
+	now := time.Now()
+	kept, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "kept", SentAt: now.Add(-2 * time.Second)})
+	if err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	if _, err := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "gone", SentAt: now.Add(-time.Second), ExpiresAt: now.Add(-time.Millisecond)}); err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+	live, err := msgs.InsertMessage(ctx, &Message{FromEmail: "bob@example.com", ToEmail: "alice@example.com", Content: "live", SentAt: now, ExpiresAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("InsertMessage failed: %v", err)
+	}
+
+	history, err := msgs.GetMessageHistory(ctx, "alice@example.com", "bob@example.com", nil, 10)
+	if err != nil {
+		t.Fatalf("GetMessageHistory failed: %v", err)
 	}
-	if len(partners) == 0 {
-		t.Fatalf("expected at least 1 partner")
+	if len(history) != 2 || history[0].ID != kept.ID || history[1].ID != live.ID {
+		t.Fatalf("expected kept and live messages, got %+v", history)
 	}
 }
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"slices"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -13,21 +14,303 @@ type User struct {
 	Password  string        `bson:"password"`
 	CreatedAt time.Time     `bson:"created_at"`
 	UpdatedAt time.Time     `bson:"updated_at"`
+	// LastSeen is when the user's last stream disconnected; LastSeenVisibility
+	// controls who may see it (empty means LastSeenEveryone)
+	LastSeen           time.Time `bson:"last_seen,omitempty"`
+	LastSeenVisibility string    `bson:"last_seen_visibility,omitempty"`
+	// Profile fields shown to other users; AvatarID references an image
+	// attachment uploaded by the user and TimeZone is an IANA zone name
+	DisplayName string        `bson:"display_name,omitempty"`
+	AvatarID    bson.ObjectID `bson:"avatar_id,omitempty"`
+	StatusText  string        `bson:"status_text,omitempty"`
+	TimeZone    string        `bson:"time_zone,omitempty"`
+	// DisplayNameKey is the lowercased display name used by SearchUsers;
+	// HiddenFromSearch keeps the user out of search results
+	DisplayNameKey   string `bson:"display_name_key,omitempty"`
+	HiddenFromSearch bool   `bson:"hidden_from_search,omitempty"`
 }
 
-// Message maps to messages collection (sender, recipient, content, sent_at)
+// ProfileUpdate lists the profile fields to change; nil fields are left as
+// they are and empty values clear the field.
+type ProfileUpdate struct {
+	DisplayName *string
+	AvatarID    *bson.ObjectID
+	StatusText  *string
+	TimeZone    *string
+}
+
+// Last seen visibility settings stored on User.
+const (
+	LastSeenEveryone = "everyone"
+	LastSeenNobody   = "nobody"
+)
+
+// LastSeenVisibleTo reports whether viewer may see the user's last_seen time.
+func (u *User) LastSeenVisibleTo(viewer string) bool {
+	return viewer == u.Email || u.LastSeenVisibility != LastSeenNobody
+}
+
+// Message maps to messages collection (sender, recipient, content, sent_at).
+// Group messages carry a GroupID and leave ToEmail empty.
 type Message struct {
 	ID        bson.ObjectID `bson:"_id,omitempty"`
 	FromEmail string        `bson:"from_email"`
 	ToEmail   string        `bson:"to_email"`
+	GroupID   bson.ObjectID `bson:"group_id,omitempty"`
 	Content   string        `bson:"content"`
 	SentAt    time.Time     `bson:"sent_at"`
 	CreatedAt time.Time     `bson:"created_at"`
+	// EditedAt is set on the latest edit; Revisions keeps the replaced contents
+	EditedAt  time.Time         `bson:"edited_at,omitempty"`
+	Revisions []MessageRevision `bson:"revisions,omitempty"`
+	// DeletedAt marks a delete-for-everyone tombstone; HiddenFor lists users who
+	// deleted the message for themselves only
+	DeletedAt time.Time `bson:"deleted_at,omitempty"`
+	HiddenFor []string  `bson:"hidden_for,omitempty"`
+	// DeliveredTo lists recipients whose clients acknowledged the message
+	DeliveredTo []string `bson:"delivered_to,omitempty"`
+	// Reactions holds at most one entry per (user, emoji) pair
+	Reactions []Reaction `bson:"reactions,omitempty"`
+	// ReplyTo quotes the message this one answers; ThreadRootID points at the
+	// first message of the thread so nested replies stay in one thread
+	ReplyTo      *QuotedMessage `bson:"reply_to,omitempty"`
+	ThreadRootID bson.ObjectID  `bson:"thread_root_id,omitempty"`
+	// Attachments snapshots the metadata of files sent with the message
+	Attachments []AttachmentRef `bson:"attachments,omitempty"`
+	// ClientMsgID is the sender's idempotency key, unique per sender
+	ClientMsgID string `bson:"client_msg_id,omitempty"`
+	// ExpiresAt is set on disappearing messages; a TTL index deletes the
+	// message once it passes
+	ExpiresAt time.Time `bson:"expires_at,omitempty"`
+	// System marks messages the server posts, e.g. expiry setting changes
+	System bool `bson:"system,omitempty"`
+	// PinnedAt and PinnedBy are set while the message is pinned in its conversation
+	PinnedAt time.Time `bson:"pinned_at,omitempty"`
+	PinnedBy string    `bson:"pinned_by,omitempty"`
+	// ForwardedFrom points at the original message when this one is a forward
+	ForwardedFrom *ForwardedMessage `bson:"forwarded_from,omitempty"`
+	// Pending marks 1-on-1 messages from a non-contact that the recipient
+	// hasn't accepted yet; they aren't delivered until then
+	Pending bool `bson:"pending,omitempty"`
+}
+
+// AttachmentRef is the attachment metadata copied onto messages
+type AttachmentRef struct {
+	ID          bson.ObjectID `bson:"attachment_id"`
+	FileName    string        `bson:"file_name"`
+	ContentType string        `bson:"content_type"`
+	Size        int64         `bson:"size"`
+}
+
+// QuotedMessage is a snapshot of a replied-to message taken when the reply was sent
+type QuotedMessage struct {
+	MsgID     bson.ObjectID `bson:"msg_id"`
+	FromEmail string        `bson:"from_email"`
+	Content   string        `bson:"content"`
+}
+
+// ForwardedMessage identifies the original of a forwarded message. Forwarding
+// a forward keeps pointing at the original.
+type ForwardedMessage struct {
+	MsgID     bson.ObjectID `bson:"msg_id"`
+	FromEmail string        `bson:"from_email"`
+	SentAt    time.Time     `bson:"sent_at"`
+}
+
+// ThreadRoot returns the ID of the thread m belongs to; a message that isn't a
+// reply is its own root.
+func (m *Message) ThreadRoot() bson.ObjectID {
+	if m.ThreadRootID.IsZero() {
+		return m.ID
+	}
+	return m.ThreadRootID
+}
+
+// Reaction is an emoji reaction left on a message by a user
+type Reaction struct {
+	Email string `bson:"email"`
+	Emoji string `bson:"emoji"`
+}
+
+// DeletedContent replaces the content of messages deleted for everyone.
+const DeletedContent = "This message was deleted"
+
+// MessageRevision is a prior content of an edited message and when it was replaced
+type MessageRevision struct {
+	Content    string    `bson:"content"`
+	ReplacedAt time.Time `bson:"replaced_at"`
+}
+
+// Group maps to groups collection (name, owner, member emails)
+type Group struct {
+	ID         bson.ObjectID `bson:"_id,omitempty"`
+	Name       string        `bson:"name"`
+	OwnerEmail string        `bson:"owner_email"`
+	Members    []string      `bson:"members"`
+	// Invites are users who don't take messages from the inviter yet; they
+	// join once they accept the inviter's message request
+	Invites []GroupInvite `bson:"invites,omitempty"`
+	// Joins records when each member joined; members of groups that predate
+	// it count from CreatedAt
+	Joins     []GroupJoin `bson:"joins,omitempty"`
+	CreatedAt time.Time   `bson:"created_at"`
+	UpdatedAt time.Time   `bson:"updated_at"`
+}
+
+// GroupJoin records when a member joined a group.
+type GroupJoin struct {
+	Email    string    `bson:"email"`
+	JoinedAt time.Time `bson:"joined_at"`
+}
+
+// GroupInvite is a pending invitation to join a group.
+type GroupInvite struct {
+	Email     string    `bson:"email"`
+	InvitedBy string    `bson:"invited_by"`
+	InvitedAt time.Time `bson:"invited_at"`
+}
+
+// HasMember reports whether email is a member of the group.
+func (g *Group) HasMember(email string) bool {
+	return slices.Contains(g.Members, email)
+}
+
+// JoinedAt returns when email joined the group, or when the group was created
+// if that wasn't recorded.
+func (g *Group) JoinedAt(email string) time.Time {
+	for _, j := range g.Joins {
+		if j.Email == email {
+			return j.JoinedAt
+		}
+	}
+	return g.CreatedAt
+}
+
+// HasInvite reports whether email has a pending invite to the group.
+func (g *Group) HasInvite(email string) bool {
+	return slices.ContainsFunc(g.Invites, func(i GroupInvite) bool { return i.Email == email })
+}
+
+// InvitedEmails returns the emails of the group's pending invites.
+func (g *Group) InvitedEmails() []string {
+	emails := make([]string, 0, len(g.Invites))
+	for _, i := range g.Invites {
+		emails = append(emails, i.Email)
+	}
+	return emails
+}
+
+// Attachment maps to attachments collection; the contents live in a blob store
+// under the attachment's hex ID
+type Attachment struct {
+	ID          bson.ObjectID `bson:"_id"`
+	OwnerEmail  string        `bson:"owner_email"`
+	FileName    string        `bson:"file_name"`
+	ContentType string        `bson:"content_type"`
+	Size        int64         `bson:"size"`
+	CreatedAt   time.Time     `bson:"created_at"`
+}
+
+// Ref returns the metadata snapshot stored on messages carrying a.
+func (a *Attachment) Ref() AttachmentRef {
+	return AttachmentRef{ID: a.ID, FileName: a.FileName, ContentType: a.ContentType, Size: a.Size}
+}
+
+// ScheduledMessage maps to scheduled_messages collection: a message waiting to
+// be sent at SendAt. Group messages carry a GroupID and leave ToEmail empty.
+type ScheduledMessage struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	FromEmail string        `bson:"from_email"`
+	ToEmail   string        `bson:"to_email,omitempty"`
+	GroupID   bson.ObjectID `bson:"group_id,omitempty"`
+	Content   string        `bson:"content"`
+	SendAt    time.Time     `bson:"send_at"`
+	// Status is one of the Scheduled* constants
+	Status string `bson:"status"`
+	// ClaimedUntil leases a due message to one dispatcher; once it expires
+	// (e.g. the server crashed mid-send) the message is claimed again
+	ClaimedUntil time.Time `bson:"claimed_until,omitempty"`
+	// MsgID is the stored message once sent; Error explains a failed send
+	MsgID     bson.ObjectID `bson:"msg_id,omitempty"`
+	Error     string        `bson:"error,omitempty"`
+	CreatedAt time.Time     `bson:"created_at"`
+	UpdatedAt time.Time     `bson:"updated_at"`
+}
+
+// Scheduled message statuses stored on ScheduledMessage.
+const (
+	ScheduledPending  = "pending"
+	ScheduledSent     = "sent"
+	ScheduledCanceled = "canceled"
+	ScheduledFailed   = "failed"
+)
+
+// Contact maps to contacts collection: OwnerEmail's relationship with
+// ContactEmail. A pending contact is a message request from ContactEmail;
+// Last* and MessageCount summarize its pending messages.
+type Contact struct {
+	ID            bson.ObjectID `bson:"_id,omitempty"`
+	OwnerEmail    string        `bson:"owner_email"`
+	ContactEmail  string        `bson:"contact_email"`
+	Status        string        `bson:"status"`
+	LastMessage   string        `bson:"last_message,omitempty"`
+	LastMessageAt time.Time     `bson:"last_message_at,omitempty"`
+	MessageCount  int64         `bson:"message_count,omitempty"`
+	CreatedAt     time.Time     `bson:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at"`
 }
 
-// ChatPartner is a minimal struct used by ListChats responses
-type ChatPartner struct {
-	Email           string
-	LastMessage     string
-	LastMessageTime time.Time
+// Contact statuses stored on Contact.
+const (
+	ContactAccepted = "accepted"
+	ContactPending  = "pending"
+	ContactDeclined = "declined"
+)
+
+// Block maps to blocks collection: BlockerEmail has blocked BlockedEmail
+type Block struct {
+	ID           bson.ObjectID `bson:"_id,omitempty"`
+	BlockerEmail string        `bson:"blocker_email"`
+	BlockedEmail string        `bson:"blocked_email"`
+	CreatedAt    time.Time     `bson:"created_at"`
+}
+
+// Conversation maps to conversations collection: one user's state in a 1-on-1
+// chat (PartnerEmail set) or a group (GroupID set)
+type Conversation struct {
+	ID           bson.ObjectID `bson:"_id,omitempty"`
+	OwnerEmail   string        `bson:"owner_email"`
+	PartnerEmail string        `bson:"partner_email,omitempty"`
+	GroupID      bson.ObjectID `bson:"group_id,omitempty"`
+	// ReadAt is the read watermark: messages sent at or before it have been read
+	ReadAt time.Time `bson:"read_at,omitempty"`
+	// LastMsgID, LastMessage, LastFromEmail and LastMessageAt summarize the
+	// newest message; they are unset until the first message arrives
+	LastMsgID     bson.ObjectID `bson:"last_msg_id,omitempty"`
+	LastMessage   string        `bson:"last_message,omitempty"`
+	LastFromEmail string        `bson:"last_from_email,omitempty"`
+	LastMessageAt time.Time     `bson:"last_message_at,omitempty"`
+	// LastExpiresAt is the last message's expiry, if it disappears
+	LastExpiresAt time.Time `bson:"last_expires_at,omitempty"`
+	// ExpirySeconds is the disappearing messages setting of a 1-on-1 chat,
+	// kept identical on both participants' documents; zero keeps messages
+	ExpirySeconds int64 `bson:"expiry_seconds,omitempty"`
+	// UnreadCount counts messages from others sent after ReadAt
+	UnreadCount int64     `bson:"unread_count"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// RefreshToken maps to refresh_tokens collection. Only a hash of the token is
+// stored. Every rotation inserts a new token in the same family and marks the
+// old one rotated, so presenting a rotated token again reveals a stolen token.
+type RefreshToken struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	FamilyID  bson.ObjectID `bson:"family_id"`
+	UserID    bson.ObjectID `bson:"user_id"`
+	Email     string        `bson:"email"`
+	TokenHash string        `bson:"token_hash"`
+	ExpiresAt time.Time     `bson:"expires_at"`
+	CreatedAt time.Time     `bson:"created_at"`
+	RotatedAt time.Time     `bson:"rotated_at,omitempty"`
+	RevokedAt time.Time     `bson:"revoked_at,omitempty"`
 }
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrPinLimit is returned by PinMessage when the conversation already has the
+// maximum number of pinned messages.
+var ErrPinLimit = errors.New("pin limit reached")
+
+// PinMessage pins a message on behalf of email and returns the updated
+// message. Messages deleted for everyone can't be pinned. At most maxPins
+// messages stay pinned per conversation: the pin is written first, then kept
+// only if it ranks among the conversation's first maxPins pins, so concurrent
+// pins can't push a conversation over the limit.
+func (m *MessagesStore) PinMessage(ctx context.Context, id bson.ObjectID, email string, pinnedAt time.Time, maxPins int) (*Message, error) {
+	filter := bson.M{"_id": id, "deleted_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"pinned_at": pinnedAt, "pinned_by": normalize.Email(email)}}
+	msg, err := m.updatePin(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the live pins ordered before this one by (pinned_at, _id)
+	rank, err := m.coll.CountDocuments(ctx, bson.M{
+		"$and": bson.A{
+			pinScope(msg),
+			bson.M{"$or": bson.A{
+				bson.M{"pinned_at": bson.M{"$lt": msg.PinnedAt}},
+				bson.M{"pinned_at": msg.PinnedAt, "_id": bson.M{"$lt": msg.ID}},
+			}},
+		},
+		"deleted_at": bson.M{"$exists": false},
+		"expires_at": unexpired(),
+	})
+	if err == nil && rank < int64(maxPins) {
+		return msg, nil
+	}
+
+	// Over the limit (or unable to tell): undo the pin unless it changed meanwhile
+	_, uerr := m.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "pinned_at": msg.PinnedAt},
+		bson.M{"$unset": bson.M{"pinned_at": "", "pinned_by": ""}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if uerr != nil {
+		return nil, uerr
+	}
+	return nil, ErrPinLimit
+}
+
+// pinScope matches the messages of msg's conversation.
+func pinScope(msg *Message) bson.M {
+	if !msg.GroupID.IsZero() {
+		return bson.M{"group_id": msg.GroupID}
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{"from_email": msg.FromEmail, "to_email": msg.ToEmail},
+		bson.M{"from_email": msg.ToEmail, "to_email": msg.FromEmail},
+	}}
+}
+
+// UnpinMessage unpins a message and returns the updated message.
+func (m *MessagesStore) UnpinMessage(ctx context.Context, id bson.ObjectID) (*Message, error) {
+	update := bson.M{"$unset": bson.M{"pinned_at": "", "pinned_by": ""}}
+	return m.updatePin(ctx, bson.M{"_id": id}, update)
+}
+
+// updatePin applies a pin update and returns the updated message.
+func (m *MessagesStore) updatePin(ctx context.Context, filter, update bson.M) (*Message, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var msg Message
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GetPinned returns up to limit pinned messages of viewer's 1-on-1 chat with
+// partner, most recently pinned first.
+func (m *MessagesStore) GetPinned(ctx context.Context, viewer, partner string, limit int64) ([]*Message, error) {
+	u1 := normalize.Email(viewer)
+	u2 := normalize.Email(partner)
+	return m.findPinned(ctx, bson.M{"$or": bson.A{
+		bson.M{"from_email": u1, "to_email": u2},
+		bson.M{"from_email": u2, "to_email": u1},
+	}}, u1, limit)
+}
+
+// GetGroupPinned returns up to limit pinned messages of a group as seen by
+// viewer, most recently pinned first.
+func (m *MessagesStore) GetGroupPinned(ctx context.Context, groupID bson.ObjectID, viewer string, limit int64) ([]*Message, error) {
+	return m.findPinned(ctx, bson.M{"group_id": groupID}, normalize.Email(viewer), limit)
+}
+
+// findPinned lists the pinned messages matched by filter. Pins of messages that
+// were since deleted for everyone or have expired are skipped, so they don't
+// count towards the conversation's pin limit either. Like history, messages
+// viewer deleted for themselves and requests they haven't accepted are left
+// out too; those still count towards the limit.
+func (m *MessagesStore) findPinned(ctx context.Context, filter bson.M, viewer string, limit int64) ([]*Message, error) {
+	filter["pinned_at"] = bson.M{"$exists": true}
+	filter["deleted_at"] = bson.M{"$exists": false}
+	filter["expires_at"] = unexpired()
+	filter["hidden_for"] = bson.M{"$ne": viewer}
+	filter["$nor"] = bson.A{pendingFor(viewer)}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "pinned_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestMessagesPins(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.MessagesCollection().Drop(ctx)
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	groupID := bson.NewObjectID()
+	first, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "the link", SentAt: now})
+	second, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "bob@example.com", ToEmail: "alice@example.com", Content: "the decision", SentAt: now.Add(time.Second)})
+	other, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "carol@example.com", GroupID: groupID, Content: "group note", SentAt: now})
+
+	if _, err := msgs.PinMessage(ctx, first.ID, "bob@example.com", now, 5); err != nil {
+		t.Fatalf("PinMessage failed: %v", err)
+	}
+	pinned, err := msgs.PinMessage(ctx, second.ID, "ALICE@example.com", now.Add(time.Minute), 5)
+	if err != nil {
+		t.Fatalf("PinMessage failed: %v", err)
+	}
+	if pinned.PinnedBy != "alice@example.com" {
+		t.Fatalf("expected normalized pinned_by, got %q", pinned.PinnedBy)
+	}
+	if _, err := msgs.PinMessage(ctx, other.ID, "carol@example.com", now, 5); err != nil {
+		t.Fatalf("PinMessage failed: %v", err)
+	}
+
+	// newest pin first, scoped to the conversation from either side
+	list, err := msgs.GetPinned(ctx, "bob@example.com", "alice@example.com", 10)
+	if err != nil {
+		t.Fatalf("GetPinned failed: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != second.ID || list[1].ID != first.ID {
+		t.Fatalf("unexpected pins: %+v", list)
+	}
+	group, err := msgs.GetGroupPinned(ctx, groupID, "alice@example.com", 10)
+	if err != nil || len(group) != 1 || group[0].ID != other.ID {
+		t.Fatalf("unexpected group pins: %+v, %v", group, err)
+	}
+
+	// unpinned and deleted messages drop out of the list
+	if _, err := msgs.UnpinMessage(ctx, second.ID); err != nil {
+		t.Fatalf("UnpinMessage failed: %v", err)
+	}
+	if _, err := msgs.DeleteMessageForEveryone(ctx, first.ID, "alice@example.com", now); err != nil {
+		t.Fatalf("DeleteMessageForEveryone failed: %v", err)
+	}
+	list, err = msgs.GetPinned(ctx, "alice@example.com", "bob@example.com", 10)
+	if err != nil || len(list) != 0 {
+		t.Fatalf("expected no pins, got %+v, %v", list, err)
+	}
+	if _, err := msgs.PinMessage(ctx, first.ID, "bob@example.com", now, 5); err != ErrMessageNotFound {
+		t.Fatalf("pinning a deleted message: got %v, want ErrMessageNotFound", err)
+	}
+
+	// the limit holds per conversation: the third pin is refused and undone
+	for i := range 3 {
+		m, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "carol@example.com", GroupID: groupID, Content: "more", SentAt: now.Add(time.Duration(i+1) * time.Second)})
+		_, err := msgs.PinMessage(ctx, m.ID, "carol@example.com", now.Add(time.Duration(i+1)*time.Minute), 3)
+		if i < 2 && err != nil {
+			t.Fatalf("PinMessage failed: %v", err)
+		}
+		if i == 2 {
+			if err != ErrPinLimit {
+				t.Fatalf("pinning past the limit: got %v, want ErrPinLimit", err)
+			}
+			if undone, _ := msgs.GetMessage(ctx, m.ID); !undone.PinnedAt.IsZero() {
+				t.Fatalf("expected the refused pin to be undone, got %+v", undone)
+			}
+		}
+	}
+	group, err = msgs.GetGroupPinned(ctx, groupID, "alice@example.com", 10)
+	if err != nil || len(group) != 3 {
+		t.Fatalf("expected 3 group pins, got %d, %v", len(group), err)
+	}
+
+	// pins of messages the viewer deleted for themselves or hasn't accepted
+	// stay out of their list, like in history
+	if err := msgs.HideMessage(ctx, group[0].ID, "alice@example.com"); err != nil {
+		t.Fatalf("HideMessage failed: %v", err)
+	}
+	if hidden, err := msgs.GetGroupPinned(ctx, groupID, "alice@example.com", 10); err != nil || len(hidden) != 2 {
+		t.Fatalf("expected 2 group pins for alice, got %d, %v", len(hidden), err)
+	}
+	if others, err := msgs.GetGroupPinned(ctx, groupID, "bob@example.com", 10); err != nil || len(others) != 3 {
+		t.Fatalf("expected 3 group pins for bob, got %d, %v", len(others), err)
+	}
+	request, _ := msgs.InsertMessage(ctx, &Message{FromEmail: "dave@example.com", ToEmail: "alice@example.com", Content: "hi", SentAt: now, Pending: true})
+	if _, err := msgs.PinMessage(ctx, request.ID, "dave@example.com", now, 5); err != nil {
+		t.Fatalf("PinMessage failed: %v", err)
+	}
+	if list, err := msgs.GetPinned(ctx, "alice@example.com", "dave@example.com", 10); err != nil || len(list) != 0 {
+		t.Fatalf("expected no pins of a pending request, got %+v, %v", list, err)
+	}
+	if list, err := msgs.GetPinned(ctx, "dave@example.com", "alice@example.com", 10); err != nil || len(list) != 1 {
+		t.Fatalf("expected the sender to see their pin, got %+v, %v", list, err)
+	}
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ErrScheduledNotFound is returned when no pending scheduled message matches,
+// including one the dispatcher is already sending.
+var ErrScheduledNotFound = errors.New("scheduled message not found")
+
+// ScheduledStore performs scheduled message DB operations.
+type ScheduledStore struct {
+	// coll is reference to "scheduled_messages" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewScheduledStore returns a ScheduledStore using the provided collection.
+func NewScheduledStore(coll *mongo.Collection) *ScheduledStore {
+	return &ScheduledStore{coll: coll}
+}
+
+// CreateScheduled stores a pending scheduled message and returns it with its
+// ID set.
+func (s *ScheduledStore) CreateScheduled(ctx context.Context, sm *ScheduledMessage) (*ScheduledMessage, error) {
+	now := time.Now()
+	sm.ID = bson.NewObjectID()
+	sm.FromEmail = normalize.Email(sm.FromEmail)
+	sm.ToEmail = normalize.Email(sm.ToEmail)
+	sm.Status = ScheduledPending
+	sm.CreatedAt = now
+	sm.UpdatedAt = now
+
+	if _, err := s.coll.InsertOne(ctx, sm); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// ListScheduled returns owner's pending scheduled messages, soonest first.
+func (s *ScheduledStore) ListScheduled(ctx context.Context, owner string, limit int64) ([]*ScheduledMessage, error) {
+	filter := bson.M{"from_email": normalize.Email(owner), "status": ScheduledPending}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "send_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := s.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var scheduled []*ScheduledMessage
+	if err := cursor.All(ctx, &scheduled); err != nil {
+		return nil, err
+	}
+	return scheduled, nil
+}
+
+// CancelScheduled cancels one of owner's pending scheduled messages. Messages
+// a dispatcher has claimed can no longer be cancelled.
+func (s *ScheduledStore) CancelScheduled(ctx context.Context, id bson.ObjectID, owner string) error {
+	now := time.Now()
+	filter := bson.M{
+		"_id":        id,
+		"from_email": normalize.Email(owner),
+		"status":     ScheduledPending,
+		"$or": bson.A{
+			bson.M{"claimed_until": bson.M{"$exists": false}},
+			bson.M{"claimed_until": bson.M{"$lt": now}},
+		},
+	}
+	result, err := s.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"status": ScheduledCanceled, "updated_at": now}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrScheduledNotFound
+	}
+	return nil
+}
+
+// ClaimDue leases the longest-overdue pending message to the caller until
+// now+lease, or returns nil if nothing is due. Because the lease lives in the
+// database, messages due while the server was down, or claimed by a server
+// that crashed, are picked up after a restart.
+func (s *ScheduledStore) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*ScheduledMessage, error) {
+	filter := bson.M{
+		"status":  ScheduledPending,
+		"send_at": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"claimed_until": bson.M{"$exists": false}},
+			bson.M{"claimed_until": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"claimed_until": now.Add(lease), "updated_at": now}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "send_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var sm ScheduledMessage
+	err := s.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&sm)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sm, nil
+}
+
+// MarkSent records that a scheduled message was stored as msgID.
+func (s *ScheduledStore) MarkSent(ctx context.Context, id, msgID bson.ObjectID) error {
+	return s.finish(ctx, id, bson.M{"status": ScheduledSent, "msg_id": msgID})
+}
+
+// MarkFailed records that a scheduled message can never be sent and why.
+func (s *ScheduledStore) MarkFailed(ctx context.Context, id bson.ObjectID, reason string) error {
+	return s.finish(ctx, id, bson.M{"status": ScheduledFailed, "error": reason})
+}
+
+// finish moves a scheduled message out of the pending state.
+func (s *ScheduledStore) finish(ctx context.Context, id bson.ObjectID, set bson.M) error {
+	set["updated_at"] = time.Now()
+	_, err := s.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set":   set,
+		"$unset": bson.M{"claimed_until": ""},
+	})
+	return err
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestScheduledClaimAndCancel(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.ScheduledMessagesCollection().Drop(ctx)
+	store := NewScheduledStore(c.ScheduledMessagesCollection())
+
+	now := time.Now()
+	due, err := store.CreateScheduled(ctx, &ScheduledMessage{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "due", SendAt: now.Add(-time.Minute)})
+	if err != nil {
+		t.Fatalf("CreateScheduled failed: %v", err)
+	}
+	later, err := store.CreateScheduled(ctx, &ScheduledMessage{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "later", SendAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("CreateScheduled failed: %v", err)
+	}
+
+	pending, err := store.ListScheduled(ctx, "alice@example.com", 10)
+	if err != nil {
+		t.Fatalf("ListScheduled failed: %v", err)
+	}
+	if len(pending) != 2 || pending[0].ID != due.ID {
+		t.Fatalf("unexpected pending list: %+v", pending)
+	}
+
+	// only the due message is claimed, and only once while the lease holds
+	claimed, err := store.ClaimDue(ctx, now, time.Minute)
+	if err != nil || claimed == nil || claimed.ID != due.ID {
+		t.Fatalf("ClaimDue = %+v, %v; want %s", claimed, err, due.ID.Hex())
+	}
+	if again, err := store.ClaimDue(ctx, now, time.Minute); err != nil || again != nil {
+		t.Fatalf("second ClaimDue = %+v, %v; want nothing", again, err)
+	}
+	if err := store.CancelScheduled(ctx, due.ID, "alice@example.com"); err != ErrScheduledNotFound {
+		t.Fatalf("cancelling a claimed message: got %v, want ErrScheduledNotFound", err)
+	}
+
+	// an expired lease (e.g. after a crash) makes the message claimable again
+	reclaimed, err := store.ClaimDue(ctx, now.Add(2*time.Minute), time.Minute)
+	if err != nil || reclaimed == nil || reclaimed.ID != due.ID {
+		t.Fatalf("reclaim = %+v, %v; want %s", reclaimed, err, due.ID.Hex())
+	}
+	if err := store.MarkSent(ctx, due.ID, bson.NewObjectID()); err != nil {
+		t.Fatalf("MarkSent failed: %v", err)
+	}
+
+	if err := store.CancelScheduled(ctx, later.ID, "bob@example.com"); err != ErrScheduledNotFound {
+		t.Fatalf("cancelling another user's message: got %v", err)
+	}
+	if err := store.CancelScheduled(ctx, later.ID, "alice@example.com"); err != nil {
+		t.Fatalf("CancelScheduled failed: %v", err)
+	}
+	pending, err = store.ListScheduled(ctx, "alice@example.com", 10)
+	if err != nil {
+		t.Fatalf("ListScheduled failed: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending messages, got %+v", pending)
+	}
+}
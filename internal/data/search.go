@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// MessageSearch is a full-text query scoped to the conversations a viewer
+// takes part in.
+type MessageSearch struct {
+	Query  string
+	Viewer string
+	// PartnerEmail limits the search to one 1-on-1 conversation. When empty,
+	// every 1-on-1 conversation of the viewer is searched unless GroupsOnly is set
+	PartnerEmail string
+	GroupsOnly   bool
+	// GroupIDs are the groups to search; callers pass only groups the viewer
+	// is currently a member of
+	GroupIDs []bson.ObjectID
+	// SentAfter/SentBefore bound sent_at when non-zero
+	SentAfter  time.Time
+	SentBefore time.Time
+	// Cursor continues a previous page; results older than it are returned
+	Cursor *HistoryCursor
+	Limit  int64
+}
+
+// SearchMessages returns messages matching q.Query via the messages text index
+// (ordered newest→oldest). Messages deleted for everyone, hidden by the
+// viewer or sent to them as a request they haven't accepted never match.
+func (m *MessagesStore) SearchMessages(ctx context.Context, q MessageSearch) ([]*Message, error) {
+	viewer := normalize.Email(q.Viewer)
+
+	// Build the set of conversations the viewer may search
+	var scope bson.A
+	if q.PartnerEmail != "" {
+		partner := normalize.Email(q.PartnerEmail)
+		scope = append(scope,
+			bson.M{"from_email": viewer, "to_email": partner},
+			bson.M{"from_email": partner, "to_email": viewer},
+		)
+	} else if !q.GroupsOnly {
+		// 1-on-1 messages carry no group_id; group messages leave to_email empty
+		scope = append(scope,
+			bson.M{"to_email": viewer},
+			bson.M{"from_email": viewer, "group_id": bson.M{"$exists": false}},
+		)
+	}
+	if len(q.GroupIDs) > 0 {
+		scope = append(scope, bson.M{"group_id": bson.M{"$in": q.GroupIDs}})
+	}
+	if len(scope) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{
+		"$text":      bson.M{"$search": q.Query},
+		"$or":        scope,
+		"deleted_at": bson.M{"$exists": false},
+		"hidden_for": bson.M{"$ne": viewer},
+		"expires_at": unexpired(),
+		"$nor":       bson.A{pendingFor(viewer)},
+	}
+	sentAt := bson.M{}
+	if !q.SentAfter.IsZero() {
+		sentAt["$gte"] = q.SentAfter
+	}
+	if !q.SentBefore.IsZero() {
+		sentAt["$lt"] = q.SentBefore
+	}
+	if len(sentAt) > 0 {
+		filter["sent_at"] = sentAt
+	}
+
+	// Newest first with the same (sent_at, _id) keyset as history pages, so
+	// pagination is stable while new messages arrive
+	opts := options.Find().
+		SetSort(bson.D{{Key: "sent_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(q.Limit)
+
+	cursor, err := m.coll.Find(ctx, keyset(filter, q.Cursor), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []*Message
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
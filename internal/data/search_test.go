@@ -0,0 +1,82 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestMessagesSearch(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	// the text index is required for $text queries
+	_ = c.MessagesCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+	msgs := NewMessagesStore(c.MessagesCollection())
+
+	now := time.Now()
+	groupID := bson.NewObjectID()
+	otherGroup := bson.NewObjectID()
+	seed := []*Message{
+		{FromEmail: "alice@example.com", ToEmail: "bob@example.com", Content: "we decided to launch friday", SentAt: now},
+		{FromEmail: "carol@example.com", ToEmail: "alice@example.com", Content: "launch checklist attached", SentAt: now.Add(time.Second)},
+		{FromEmail: "carol@example.com", GroupID: groupID, Content: "launch party at six", SentAt: now.Add(2 * time.Second)},
+		{FromEmail: "dave@example.com", ToEmail: "erin@example.com", Content: "secret launch plans", SentAt: now.Add(3 * time.Second)},
+		{FromEmail: "dave@example.com", GroupID: otherGroup, Content: "launch in another group", SentAt: now.Add(4 * time.Second)},
+	}
+	for _, m := range seed {
+		if _, err := msgs.InsertMessage(ctx, m); err != nil {
+			t.Fatalf("InsertMessage failed: %v", err)
+		}
+	}
+
+	// only alice's conversations and groups match, newest first
+	found, err := msgs.SearchMessages(ctx, MessageSearch{Query: "launch", Viewer: "alice@example.com", GroupIDs: []bson.ObjectID{groupID}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchMessages failed: %v", err)
+	}
+	if len(found) != 3 || found[0].ID != seed[2].ID || found[2].ID != seed[0].ID {
+		t.Fatalf("unexpected results: %+v", found)
+	}
+
+	// partner filter plus cursor
+	found, err = msgs.SearchMessages(ctx, MessageSearch{Query: "launch", Viewer: "alice@example.com", PartnerEmail: "carol@example.com", Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchMessages partner failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != seed[1].ID {
+		t.Fatalf("unexpected partner results: %+v", found)
+	}
+	found, err = msgs.SearchMessages(ctx, MessageSearch{Query: "launch", Viewer: "alice@example.com", Cursor: CursorAt(seed[1], false), Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchMessages cursor failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != seed[0].ID {
+		t.Fatalf("unexpected page after cursor: %+v", found)
+	}
+
+	// date range excludes the first message
+	found, err = msgs.SearchMessages(ctx, MessageSearch{Query: "launch", Viewer: "alice@example.com", SentAfter: now.Add(500 * time.Millisecond), Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchMessages range failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != seed[1].ID {
+		t.Fatalf("unexpected ranged results: %+v", found)
+	}
+}
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var (
+	// ErrRefreshTokenInvalid is returned for unknown, expired or revoked refresh tokens.
+	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+	// ErrRefreshTokenReused is returned when an already rotated refresh token is
+	// presented again; its whole family has been revoked by then.
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// RefreshTokensStore performs refresh token DB operations.
+type RefreshTokensStore struct {
+	// coll is reference to "refresh_tokens" collection in MongoDB
+	coll *mongo.Collection
+}
+
+// NewRefreshTokensStore returns a RefreshTokensStore using the provided collection.
+func NewRefreshTokensStore(coll *mongo.Collection) *RefreshTokensStore {
+	return &RefreshTokensStore{coll: coll}
+}
+
+// CreateToken stores t. A zero FamilyID starts a new token family, as on login.
+func (r *RefreshTokensStore) CreateToken(ctx context.Context, t *RefreshToken) (*RefreshToken, error) {
+	if t.FamilyID.IsZero() {
+		t.FamilyID = bson.NewObjectID()
+	}
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = time.Now()
+	}
+
+	result, err := r.coll.InsertOne(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	t.ID = result.InsertedID.(bson.ObjectID)
+	return t, nil
+}
+
+// Rotate marks the live refresh token with the given hash as rotated and
+// returns it, so the caller can issue its successor in the same family. If
+// the token was rotated before, the family is revoked and
+// ErrRefreshTokenReused returned: either the client or an attacker holds a
+// stolen copy, and there is no telling which.
+func (r *RefreshTokensStore) Rotate(ctx context.Context, hash string, now time.Time) (*RefreshToken, error) {
+	filter := bson.M{
+		"token_hash": hash,
+		"rotated_at": bson.M{"$exists": false},
+		"revoked_at": bson.M{"$exists": false},
+		"expires_at": bson.M{"$gt": now},
+	}
+
+	var t RefreshToken
+	err := r.coll.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"rotated_at": now}}).Decode(&t)
+	if err == nil {
+		t.RotatedAt = now
+		return &t, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	// Find out why the token can't be used
+	err = r.coll.FindOne(ctx, bson.M{"token_hash": hash}).Decode(&t)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrRefreshTokenInvalid
+		}
+		return nil, err
+	}
+	if t.RotatedAt.IsZero() {
+		return nil, ErrRefreshTokenInvalid
+	}
+	if err := r.RevokeFamily(ctx, t.FamilyID, now); err != nil {
+		return nil, err
+	}
+	return nil, ErrRefreshTokenReused
+}
+
+// RevokeFamily revokes every refresh token descending from the same login.
+func (r *RefreshTokensStore) RevokeFamily(ctx context.Context, familyID bson.ObjectID, now time.Time) error {
+	_, err := r.coll.UpdateMany(ctx,
+		bson.M{"family_id": familyID, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": now}},
+	)
+	return err
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestRefreshTokensRotation(t *testing.T) {
+	uri := os.Getenv("MONGODB_URI")
+	if uri == "" {
+		t.Skip("MONGODB_URI not set; skipping integration test")
+	}
+
+	ctx := context.Background()
+	c, err := db.New(ctx, uri)
+	if err != nil {
+		t.Fatalf("db.New failed: %v", err)
+	}
+	defer func() { _ = c.Close(context.Background()) }()
+
+	_ = c.RefreshTokensCollection().Drop(ctx)
+	if err := c.CreateIndexes(ctx); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+	tokens := NewRefreshTokensStore(c.RefreshTokensCollection())
+
+	now := time.Now()
+	user := bson.NewObjectID()
+	first, err := tokens.CreateToken(ctx, &RefreshToken{UserID: user, Email: "ada@example.com", TokenHash: "hash-1", ExpiresAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+	if first.FamilyID.IsZero() {
+		t.Fatal("expected a new token family")
+	}
+
+	// rotating the live token hands it back and its successor joins the family
+	rotated, err := tokens.Rotate(ctx, "hash-1", now)
+	if err != nil || rotated.ID != first.ID {
+		t.Fatalf("Rotate = %+v, %v; want the first token", rotated, err)
+	}
+	if _, err := tokens.CreateToken(ctx, &RefreshToken{FamilyID: rotated.FamilyID, UserID: user, Email: "ada@example.com", TokenHash: "hash-2", ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+
+	// an unrelated login is a separate family
+	if _, err := tokens.CreateToken(ctx, &RefreshToken{UserID: user, Email: "ada@example.com", TokenHash: "other", ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+
+	// presenting the rotated token again revokes the whole family
+	if _, err := tokens.Rotate(ctx, "hash-1", now); err != ErrRefreshTokenReused {
+		t.Fatalf("reusing a rotated token: got %v, want ErrRefreshTokenReused", err)
+	}
+	if _, err := tokens.Rotate(ctx, "hash-2", now); err != ErrRefreshTokenInvalid {
+		t.Fatalf("successor of a reused token: got %v, want ErrRefreshTokenInvalid", err)
+	}
+	if _, err := tokens.Rotate(ctx, "other", now); err != nil {
+		t.Fatalf("other family should be unaffected: %v", err)
+	}
+
+	// unknown and expired tokens are invalid
+	if _, err := tokens.Rotate(ctx, "missing", now); err != ErrRefreshTokenInvalid {
+		t.Fatalf("unknown token: got %v, want ErrRefreshTokenInvalid", err)
+	}
+	if _, err := tokens.CreateToken(ctx, &RefreshToken{UserID: user, Email: "ada@example.com", TokenHash: "expired", ExpiresAt: now.Add(-time.Minute)}); err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+	if _, err := tokens.Rotate(ctx, "expired", now); err != ErrRefreshTokenInvalid {
+		t.Fatalf("expired token: got %v, want ErrRefreshTokenInvalid", err)
+	}
+}
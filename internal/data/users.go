@@ -3,14 +3,20 @@ package data
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/normalize"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// ErrUserNotFound is returned when a user lookup matches nothing.
+var ErrUserNotFound = errors.New("user not found")
+
 // UsersStore performs user DB operations.
 type UsersStore struct {
 	// coll is reference to "users" collection in MongoDB
@@ -67,7 +73,7 @@ func (u *UsersStore) GetUserByEmail(ctx context.Context, email string) (*User, e
 	if err != nil {
 		// Check if no document found (user doesn't exist)
 		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		// Other database errors
 		return nil, err
@@ -89,7 +95,7 @@ func (u *UsersStore) GetUserByID(ctx context.Context, id bson.ObjectID) (*User,
 	if err != nil {
 		// No document found (user was deleted)
 		if err == mongo.ErrNoDocuments {
-			return nil, errors.New("user not found")
+			return nil, ErrUserNotFound
 		}
 		// Database errors
 		return nil, err
@@ -113,3 +119,172 @@ func (u *UsersStore) UserExists(ctx context.Context, email string) (bool, error)
 	// Return true if at least one document found, false otherwise
 	return count > 0, nil
 }
+
+// SetLastSeen records when the user's last stream disconnected.
+func (u *UsersStore) SetLastSeen(ctx context.Context, email string, at time.Time) error {
+	_, err := u.coll.UpdateOne(ctx,
+		bson.M{"email": normalize.Email(email)},
+		bson.M{"$set": bson.M{"last_seen": at}},
+	)
+	return err
+}
+
+// SetLastSeenVisibility stores who may see the user's last_seen time
+// (LastSeenEveryone or LastSeenNobody).
+func (u *UsersStore) SetLastSeenVisibility(ctx context.Context, email, visibility string) error {
+	result, err := u.coll.UpdateOne(ctx,
+		bson.M{"email": normalize.Email(email)},
+		bson.M{"$set": bson.M{"last_seen_visibility": visibility, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// GetUsersByEmails finds the users with the given emails. Unknown emails are
+// skipped, so the result may be shorter than emails.
+func (u *UsersStore) GetUsersByEmails(ctx context.Context, emails []string) ([]*User, error) {
+	normalized := make([]string, len(emails))
+	for i, e := range emails {
+		normalized[i] = normalize.Email(e)
+	}
+
+	cursor, err := u.coll.Find(ctx, bson.M{"email": bson.M{"$in": normalized}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// UpdateProfile applies p to the user's profile and returns the updated user.
+func (u *UsersStore) UpdateProfile(ctx context.Context, email string, p ProfileUpdate) (*User, error) {
+	set := bson.M{"updated_at": time.Now()}
+	unset := bson.M{}
+	setString := func(field string, v *string) {
+		switch {
+		case v == nil:
+		case *v == "":
+			unset[field] = ""
+		default:
+			set[field] = *v
+		}
+	}
+	setString("display_name", p.DisplayName)
+	if p.DisplayName != nil {
+		key := strings.ToLower(*p.DisplayName)
+		setString("display_name_key", &key)
+	}
+	setString("status_text", p.StatusText)
+	setString("time_zone", p.TimeZone)
+	if p.AvatarID != nil {
+		if p.AvatarID.IsZero() {
+			unset["avatar_id"] = ""
+		} else {
+			set["avatar_id"] = *p.AvatarID
+		}
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	var user User
+	err := u.coll.FindOneAndUpdate(ctx,
+		bson.M{"email": normalize.Email(email)},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// AvatarInUse reports whether any user has the attachment as their avatar.
+func (u *UsersStore) AvatarInUse(ctx context.Context, attachmentID bson.ObjectID) (bool, error) {
+	count, err := u.coll.CountDocuments(ctx, bson.M{"avatar_id": attachmentID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SetDiscoverable controls whether the user shows up in SearchUsers results.
+func (u *UsersStore) SetDiscoverable(ctx context.Context, email string, discoverable bool) error {
+	set := bson.M{"updated_at": time.Now()}
+	update := bson.M{"$set": set}
+	if discoverable {
+		update["$unset"] = bson.M{"hidden_from_search": ""}
+	} else {
+		set["hidden_from_search"] = true
+	}
+	result, err := u.coll.UpdateOne(ctx, bson.M{"email": normalize.Email(email)}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// UserSearch describes a SearchUsers query.
+type UserSearch struct {
+	// EmailPrefix and NamePrefix match the start of the email or display name
+	// (case-insensitively); a user matching either is returned
+	EmailPrefix string
+	NamePrefix  string
+	// Exclude lists emails never to return, such as the searcher themselves
+	Exclude []string
+	Limit   int64
+}
+
+// SearchUsers finds discoverable users by email or display name prefix,
+// ordered by email.
+func (u *UsersStore) SearchUsers(ctx context.Context, q UserSearch) ([]*User, error) {
+	var match bson.A
+	if q.EmailPrefix != "" {
+		match = append(match, bson.M{"email": bson.M{"$regex": "^" + regexp.QuoteMeta(normalize.Email(q.EmailPrefix))}})
+	}
+	if q.NamePrefix != "" {
+		match = append(match, bson.M{"display_name_key": bson.M{"$regex": "^" + regexp.QuoteMeta(strings.ToLower(q.NamePrefix))}})
+	}
+	if len(match) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{
+		"$or":                match,
+		"hidden_from_search": bson.M{"$ne": true},
+	}
+	if len(q.Exclude) > 0 {
+		filter["email"] = bson.M{"$nin": q.Exclude}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "email", Value: 1}}).SetLimit(q.Limit)
+	cursor, err := u.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/PaulBabatuyi/reaTimeChat-gRPC/internal/db"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 func setupDB(t *testing.T) *db.Client {
@@ -109,3 +110,148 @@ func TestUsersNormalization(t *testing.T) {
 		t.Fatalf("GetUserByEmail returned wrong email: %s", u2.Email)
 	}
 }
+
+func TestUsersLastSeen(t *testing.T) {
+	c := setupDB(t)
+	defer func() { _ = c.Close(context.Background()) }()
+
+	users := NewUsersStore(c.UsersCollection())
+	ctx := context.Background()
+
+	if _, err := users.CreateUser(ctx, "seen@example.com", "hashed-password"); err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+
+	at := time.Now().Truncate(time.Millisecond)
+	if err := users.SetLastSeen(ctx, "Seen@Example.com", at); err != nil {
+		t.Fatalf("SetLastSeen failed: %v", err)
+	}
+	if err := users.SetLastSeenVisibility(ctx, "seen@example.com", LastSeenNobody); err != nil {
+		t.Fatalf("SetLastSeenVisibility failed: %v", err)
+	}
+
+	u, err := users.GetUserByEmail(ctx, "seen@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail failed: %v", err)
+	}
+	if !u.LastSeen.Equal(at) {
+		t.Fatalf("expected last seen %v, got %v", at, u.LastSeen)
+	}
+	if u.LastSeenVisibleTo("other@example.com") || !u.LastSeenVisibleTo("seen@example.com") {
+		t.Fatalf("last seen should only be visible to the user themselves")
+	}
+
+	if err := users.SetLastSeenVisibility(ctx, "missing@example.com", LastSeenNobody); err != ErrUserNotFound {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUsersProfile(t *testing.T) {
+	c := setupDB(t)
+	defer func() { _ = c.Close(context.Background()) }()
+	if err := c.CreateIndexes(context.Background()); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	users := NewUsersStore(c.UsersCollection())
+	ctx := context.Background()
+
+	for _, e := range []string{"ada@example.com", "bob@example.com"} {
+		if _, err := users.CreateUser(ctx, e, "hashed-password"); err != nil {
+			t.Fatalf("CreateUser failed: %v", err)
+		}
+	}
+
+	name, status, tz := "Ada", "at lunch", "Europe/London"
+	avatar := bson.NewObjectID()
+	u, err := users.UpdateProfile(ctx, "Ada@Example.com", ProfileUpdate{DisplayName: &name, AvatarID: &avatar, StatusText: &status, TimeZone: &tz})
+	if err != nil {
+		t.Fatalf("UpdateProfile failed: %v", err)
+	}
+	if u.DisplayName != "Ada" || u.AvatarID != avatar || u.StatusText != "at lunch" || u.TimeZone != "Europe/London" {
+		t.Fatalf("unexpected profile: %+v", u)
+	}
+
+	// nil fields are kept, empty ones cleared
+	empty := ""
+	u, err = users.UpdateProfile(ctx, "ada@example.com", ProfileUpdate{StatusText: &empty})
+	if err != nil {
+		t.Fatalf("UpdateProfile failed: %v", err)
+	}
+	if u.StatusText != "" || u.DisplayName != "Ada" {
+		t.Fatalf("unexpected profile after clearing status: %+v", u)
+	}
+
+	inUse, err := users.AvatarInUse(ctx, avatar)
+	if err != nil || !inUse {
+		t.Fatalf("AvatarInUse = %v, %v; want true", inUse, err)
+	}
+
+	found, err := users.GetUsersByEmails(ctx, []string{"ADA@example.com", "bob@example.com", "missing@example.com"})
+	if err != nil {
+		t.Fatalf("GetUsersByEmails failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(found))
+	}
+
+	if _, err := users.UpdateProfile(ctx, "missing@example.com", ProfileUpdate{DisplayName: &name}); err != ErrUserNotFound {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUsersSearch(t *testing.T) {
+	c := setupDB(t)
+	defer func() { _ = c.Close(context.Background()) }()
+	if err := c.CreateIndexes(context.Background()); err != nil {
+		t.Fatalf("CreateIndexes failed: %v", err)
+	}
+
+	users := NewUsersStore(c.UsersCollection())
+	ctx := context.Background()
+
+	for _, e := range []string{"alice@example.com", "albert@example.com", "bob@example.com", "hidden@example.com"} {
+		if _, err := users.CreateUser(ctx, e, "hashed-password"); err != nil {
+			t.Fatalf("CreateUser failed: %v", err)
+		}
+	}
+	name := "Alfred Bobson"
+	if _, err := users.UpdateProfile(ctx, "bob@example.com", ProfileUpdate{DisplayName: &name}); err != nil {
+		t.Fatalf("UpdateProfile failed: %v", err)
+	}
+	hiddenName := "Al Hidden"
+	if _, err := users.UpdateProfile(ctx, "hidden@example.com", ProfileUpdate{DisplayName: &hiddenName}); err != nil {
+		t.Fatalf("UpdateProfile failed: %v", err)
+	}
+	if err := users.SetDiscoverable(ctx, "hidden@example.com", false); err != nil {
+		t.Fatalf("SetDiscoverable failed: %v", err)
+	}
+
+	// "AL" matches two emails and one display name, but not the hidden user
+	// or the excluded searcher
+	found, err := users.SearchUsers(ctx, UserSearch{EmailPrefix: "AL", NamePrefix: "AL", Exclude: []string{"alice@example.com"}, Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchUsers failed: %v", err)
+	}
+	if len(found) != 2 || found[0].Email != "albert@example.com" || found[1].Email != "bob@example.com" {
+		t.Fatalf("unexpected results: %+v", found)
+	}
+
+	// regex metacharacters are matched literally
+	found, err = users.SearchUsers(ctx, UserSearch{EmailPrefix: ".*", NamePrefix: ".*", Limit: 10})
+	if err != nil || len(found) != 0 {
+		t.Fatalf("expected no results for a regex query, got %+v, %v", found, err)
+	}
+
+	if err := users.SetDiscoverable(ctx, "hidden@example.com", true); err != nil {
+		t.Fatalf("SetDiscoverable failed: %v", err)
+	}
+	found, err = users.SearchUsers(ctx, UserSearch{NamePrefix: "al h", Limit: 10})
+	if err != nil || len(found) != 1 || found[0].Email != "hidden@example.com" {
+		t.Fatalf("expected the user to be discoverable again, got %+v, %v", found, err)
+	}
+
+	if err := users.SetDiscoverable(ctx, "missing@example.com", false); err != ErrUserNotFound {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -16,7 +17,7 @@ type Client struct {
 	client *mongo.Client
 
 	// db is reference to "chat_db" database within MongoDB
-	// Collections ("users", "messages") are accessed via this db reference
+	// Collections ("users", "messages", "groups", "conversations", "attachments") are accessed via this db reference
 	db *mongo.Database
 }
 
@@ -71,6 +72,51 @@ func (c *Client) MessagesCollection() *mongo.Collection {
 	return c.db.Collection("messages")
 }
 
+// GroupsCollection returns the groups collection.
+func (c *Client) GroupsCollection() *mongo.Collection {
+	return c.db.Collection("groups")
+}
+
+// ConversationsCollection returns the conversations collection.
+func (c *Client) ConversationsCollection() *mongo.Collection {
+	return c.db.Collection("conversations")
+}
+
+// AttachmentsCollection returns the attachments metadata collection.
+func (c *Client) AttachmentsCollection() *mongo.Collection {
+	return c.db.Collection("attachments")
+}
+
+// ContactsCollection returns the contacts and message requests collection.
+func (c *Client) ContactsCollection() *mongo.Collection {
+	return c.db.Collection("contacts")
+}
+
+// RefreshTokensCollection returns the hashed refresh tokens collection.
+func (c *Client) RefreshTokensCollection() *mongo.Collection {
+	return c.db.Collection("refresh_tokens")
+}
+
+// BlocksCollection returns the user blocks collection.
+func (c *Client) BlocksCollection() *mongo.Collection {
+	return c.db.Collection("blocks")
+}
+
+// ScheduledMessagesCollection returns the scheduled messages collection.
+func (c *Client) ScheduledMessagesCollection() *mongo.Collection {
+	return c.db.Collection("scheduled_messages")
+}
+
+// MigrationsCollection returns the collection recording completed one-off migrations.
+func (c *Client) MigrationsCollection() *mongo.Collection {
+	return c.db.Collection("migrations")
+}
+
+// AttachmentsBucket returns the GridFS bucket used by the GridFS blob backend.
+func (c *Client) AttachmentsBucket() *mongo.GridFSBucket {
+	return c.db.GridFSBucket(options.GridFSBucket().SetName("blobs"))
+}
+
 // Close disconnects from MongoDB.
 func (c *Client) Close(ctx context.Context) error {
 	// Disconnect closes the MongoDB connection
@@ -78,9 +124,33 @@ func (c *Client) Close(ctx context.Context) error {
 	return c.client.Disconnect(ctx)
 }
 
-// CreateIndexes creates necessary indexes for users and messages collections.
+// RunMigration runs fn unless a migration called name has already completed,
+// then records it as completed. A failed migration runs again on the next
+// start, so fn must be safe to repeat.
+func (c *Client) RunMigration(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	err := c.MigrationsCollection().FindOne(ctx, bson.M{"_id": name}).Err()
+	if err == nil {
+		return nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return fmt.Errorf("failed to check migration %s: %w", name, err)
+	}
+
+	if err := fn(ctx); err != nil {
+		return fmt.Errorf("migration %s failed: %w", name, err)
+	}
+
+	// Another instance may have finished the same migration meanwhile
+	_, err = c.MigrationsCollection().InsertOne(ctx, bson.M{"_id": name, "completed_at": time.Now()})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to record migration %s: %w", name, err)
+	}
+	return nil
+}
+
+// CreateIndexes creates necessary indexes for users, messages, groups and conversations collections.
 func (c *Client) CreateIndexes(ctx context.Context) error {
-	// ===== USERS COLLECTION INDEX =====
+	// ===== USERS COLLECTION INDEXES =====
 	// Create unique index on email field
 	// Ensures: no two users can have the same email (unique constraint)
 	// Used by: GetUserByEmail() queries, prevents duplicate registration
@@ -91,34 +161,241 @@ func (c *Client) CreateIndexes(ctx context.Context) error {
 		Options: options.Index().SetUnique(true),
 	}
 
+	// Partial index on avatar_id: only users with an avatar are indexed
+	// Used by: AvatarInUse() when authorizing avatar downloads
+	avatarIndexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "avatar_id", Value: 1}},
+		Options: options.Index().SetPartialFilterExpression(
+			bson.M{"avatar_id": bson.M{"$exists": true}},
+		),
+	}
+
+	// Partial index on display_name_key: only users with a display name are indexed
+	// Used by: SearchUsers() display name prefix matches
+	nameIndexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "display_name_key", Value: 1}},
+		Options: options.Index().SetPartialFilterExpression(
+			bson.M{"display_name_key": bson.M{"$exists": true}},
+		),
+	}
+
 	// Execute index creation on users collection
-	_, err := c.UsersCollection().Indexes().CreateOne(ctx, usersIndexModel)
+	_, err := c.UsersCollection().Indexes().CreateMany(ctx, []mongo.IndexModel{usersIndexModel, avatarIndexModel, nameIndexModel})
 	if err != nil {
 		return fmt.Errorf("failed to create users index: %w", err)
 	}
 
 	// ===== MESSAGES COLLECTION INDEXES =====
-	// Create two indexes for efficient message queries
+	// Create indexes for efficient message queries
 	messageIndexes := []mongo.IndexModel{
 		{
 			// Composite index: (from_email, to_email, sent_at)
 			// Used by: GetMessageHistory() to find all messages between two users, ordered by time
 			// 1 = ascending, -1 = descending (newest first for sent_at)
-			Keys: map[string]int{"from_email": 1, "to_email": 1, "sent_at": -1},
+			// _id breaks sent_at ties for history cursors
+			Keys: bson.D{{Key: "from_email", Value: 1}, {Key: "to_email", Value: 1}, {Key: "sent_at", Value: -1}, {Key: "_id", Value: -1}},
 		},
 		{
 			// Simple index: just sent_at
-			// Used by: GetRecentChats() aggregation to sort by time
+			// Used by: ConversationSummaries() aggregation to sort by time
 			Keys: map[string]int{"sent_at": -1},
 		},
+		{
+			// Composite index: (group_id, sent_at)
+			// Used by: GetGroupHistory()
+			// bson.D keeps key order, which matters for compound indexes
+			Keys: bson.D{{Key: "group_id", Value: 1}, {Key: "sent_at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			// Composite index: (to_email, sent_at)
+			// Used by: GetUndelivered() to find a reconnecting user's unacknowledged messages
+			Keys: bson.D{{Key: "to_email", Value: 1}, {Key: "sent_at", Value: 1}},
+		},
+		{
+			// Composite index: (thread_root_id, sent_at)
+			// Used by: GetThread() to list a thread's replies in order
+			Keys: bson.D{{Key: "thread_root_id", Value: 1}, {Key: "sent_at", Value: 1}},
+		},
+		{
+			// Index on the quoted message ID
+			// Used by: DeleteMessageForEveryone() to scrub quotes of a deleted message
+			Keys: map[string]int{"reply_to.msg_id": 1},
+		},
+		{
+			// Multikey index on attachment IDs
+			// Used by: GetMessagesWithAttachment() to authorize downloads
+			Keys: map[string]int{"attachments.attachment_id": 1},
+		},
+		{
+			// Unique per sender: retried sends with the same client_msg_id collide
+			// Partial filter leaves messages without a client ID unconstrained
+			// Used by: InsertMessage() and GetMessageByClientID()
+			Keys: bson.D{{Key: "from_email", Value: 1}, {Key: "client_msg_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(
+				bson.M{"client_msg_id": bson.M{"$exists": true}},
+			),
+		},
+		{
+			// Text index on content (a collection can only have one)
+			// Used by: SearchMessages() for full-text queries with stemming
+			Keys:    bson.D{{Key: "content", Value: "text"}},
+			Options: options.Index().SetName("content_text"),
+		},
+		{
+			// TTL index: MongoDB deletes disappearing messages once expires_at
+			// passes; messages without expires_at are never removed
+			// Used by: disappearing messages (SetMessageExpiry)
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			// Partial index on pinned_at: only pinned messages are indexed
+			// Used by: GetPinned() and GetGroupPinned()
+			Keys: bson.D{{Key: "pinned_at", Value: -1}},
+			Options: options.Index().SetPartialFilterExpression(
+				bson.M{"pinned_at": bson.M{"$exists": true}},
+			),
+		},
 	}
 
-	// Execute index creation on messages collection (creates both indexes)
+	// Execute index creation on messages collection
 	_, err = c.MessagesCollection().Indexes().CreateMany(ctx, messageIndexes)
 	if err != nil {
 		return fmt.Errorf("failed to create message indexes: %w", err)
 	}
 
+	// ===== GROUPS COLLECTION INDEXES =====
+	groupIndexes := []mongo.IndexModel{
+		{
+			// Multikey index on members array
+			// Used by: ListGroupsForUser() to find every group a user belongs to
+			Keys: map[string]int{"members": 1},
+		},
+		{
+			// Multikey index on invitees
+			// Used by: AcceptInvites(), DropInvites() to find a user's pending invites
+			Keys: bson.D{{Key: "invites.email", Value: 1}, {Key: "invites.invited_by", Value: 1}},
+		},
+	}
+	_, err = c.GroupsCollection().Indexes().CreateMany(ctx, groupIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create groups indexes: %w", err)
+	}
+
+	// ===== CONVERSATIONS COLLECTION INDEXES =====
+	conversationIndexes := []mongo.IndexModel{
+		{
+			// One document per (owner, partner) 1-on-1 chat
+			// Partial filter keeps group documents (no partner_email) out of the constraint
+			Keys: bson.D{{Key: "owner_email", Value: 1}, {Key: "partner_email", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(
+				bson.M{"partner_email": bson.M{"$exists": true}},
+			),
+		},
+		{
+			// One document per (group, member)
+			// Used by: GetGroupReadAt() to collect every member's watermark
+			Keys: bson.D{{Key: "group_id", Value: 1}, {Key: "owner_email", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(
+				bson.M{"group_id": bson.M{"$exists": true}},
+			),
+		},
+		{
+			// Composite index: (owner_email, last_message_at)
+			// Used by: ListConversations() to page a user's chats by recent activity
+			Keys: bson.D{{Key: "owner_email", Value: 1}, {Key: "last_message_at", Value: -1}},
+		},
+		{
+			// Simple index: last_msg_id
+			// Used by: UpdateLastMessage() to refresh previews after edits and deletes
+			Keys: map[string]int{"last_msg_id": 1},
+		},
+	}
+	_, err = c.ConversationsCollection().Indexes().CreateMany(ctx, conversationIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create conversations indexes: %w", err)
+	}
+
+	// ===== SCHEDULED MESSAGES COLLECTION INDEXES =====
+	scheduledIndexes := []mongo.IndexModel{
+		{
+			// Composite index: (status, send_at)
+			// Used by: ClaimDue() to find the longest-overdue pending message
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "send_at", Value: 1}},
+		},
+		{
+			// Composite index: (from_email, status, send_at)
+			// Used by: ListScheduled() to list a user's pending messages in order
+			Keys: bson.D{{Key: "from_email", Value: 1}, {Key: "status", Value: 1}, {Key: "send_at", Value: 1}},
+		},
+	}
+	_, err = c.ScheduledMessagesCollection().Indexes().CreateMany(ctx, scheduledIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled messages indexes: %w", err)
+	}
+
+	// ===== BLOCKS COLLECTION INDEXES =====
+	blockIndexes := []mongo.IndexModel{
+		{
+			// Unique composite index: (blocker_email, blocked_email)
+			// Used by: Block() upserts, ListBlocked() and both halves of BlockBetween()
+			Keys:    bson.D{{Key: "blocker_email", Value: 1}, {Key: "blocked_email", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// Composite index: (blocked_email, blocker_email)
+			// Used by: BlockedWith() and BlockedAmong() to find who blocked a user
+			Keys: bson.D{{Key: "blocked_email", Value: 1}, {Key: "blocker_email", Value: 1}},
+		},
+	}
+	_, err = c.BlocksCollection().Indexes().CreateMany(ctx, blockIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create blocks indexes: %w", err)
+	}
+
+	// ===== CONTACTS COLLECTION INDEXES =====
+	contactIndexes := []mongo.IndexModel{
+		{
+			// Unique composite index: (owner_email, contact_email)
+			// Used by: GetContact(), Accept(), RecordRequest() and Decline()
+			Keys:    bson.D{{Key: "owner_email", Value: 1}, {Key: "contact_email", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// Composite index: (owner_email, status, last_message_at)
+			// Used by: ListRequests() to list pending requests newest first
+			Keys: bson.D{{Key: "owner_email", Value: 1}, {Key: "status", Value: 1}, {Key: "last_message_at", Value: -1}},
+		},
+	}
+	_, err = c.ContactsCollection().Indexes().CreateMany(ctx, contactIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create contacts indexes: %w", err)
+	}
+
+	// ===== REFRESH TOKENS COLLECTION INDEXES =====
+	refreshTokenIndexes := []mongo.IndexModel{
+		{
+			// Unique index on token_hash
+			// Used by: Rotate() to look up presented tokens
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// Index on family_id
+			// Used by: RevokeFamily() when a rotated token is reused
+			Keys: bson.D{{Key: "family_id", Value: 1}},
+		},
+		{
+			// TTL index: MongoDB removes tokens once they expire
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	_, err = c.RefreshTokensCollection().Indexes().CreateMany(ctx, refreshTokenIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token indexes: %w", err)
+	}
+
 	// All indexes created successfully
 	return nil
 }
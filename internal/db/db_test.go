@@ -26,6 +26,14 @@ func TestNewAndCreateIndexes(t *testing.T) {
 		// drop the testing collections and close connection
 		_ = c.db.Collection("users").Drop(context.Background())
 		_ = c.db.Collection("messages").Drop(context.Background())
+		_ = c.db.Collection("groups").Drop(context.Background())
+		_ = c.db.Collection("conversations").Drop(context.Background())
+		_ = c.db.Collection("attachments").Drop(context.Background())
+		_ = c.db.Collection("scheduled_messages").Drop(context.Background())
+		_ = c.db.Collection("blocks").Drop(context.Background())
+		_ = c.db.Collection("contacts").Drop(context.Background())
+		_ = c.db.Collection("refresh_tokens").Drop(context.Background())
+		_ = c.db.Collection("migrations").Drop(context.Background())
 		_ = c.Close(context.Background())
 	}()
 
@@ -34,6 +42,20 @@ func TestNewAndCreateIndexes(t *testing.T) {
 		t.Fatalf("CreateIndexes failed: %v", err)
 	}
 
+	// a completed migration is skipped on the next run
+	runs := 0
+	for i := 0; i < 2; i++ {
+		if err := c.RunMigration(ctx, "test_migration", func(context.Context) error {
+			runs++
+			return nil
+		}); err != nil {
+			t.Fatalf("RunMigration failed: %v", err)
+		}
+	}
+	if runs != 1 {
+		t.Fatalf("expected the migration to run once, ran %d times", runs)
+	}
+
 	// quick sanity sleep to allow DB to finalize
 	time.Sleep(100 * time.Millisecond)
 }
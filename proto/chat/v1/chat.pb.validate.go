@@ -368,6 +368,14 @@ func (m *GetHistoryRequest) validate(all bool) error {
 
 	// no validation rules for WithEmail
 
+	// no validation rules for GroupId
+
+	// no validation rules for BeforeMsgId
+
+	// no validation rules for AfterMsgId
+
+	// no validation rules for PageSize
+
 	if len(errors) > 0 {
 		return GetHistoryRequestMultiError(errors)
 	}
@@ -474,6 +482,218 @@ func (m *ChatStreamRequest) validate(all bool) error {
 
 	// no validation rules for Content
 
+	// no validation rules for GroupId
+
+	switch v := m.Event.(type) {
+	case *ChatStreamRequest_Message:
+		if v == nil {
+			err := ChatStreamRequestValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamRequestValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamRequest_TypingStarted:
+		if v == nil {
+			err := ChatStreamRequestValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTypingStarted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "TypingStarted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "TypingStarted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTypingStarted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamRequestValidationError{
+					field:  "TypingStarted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamRequest_TypingStopped:
+		if v == nil {
+			err := ChatStreamRequestValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTypingStopped()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "TypingStopped",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "TypingStopped",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTypingStopped()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamRequestValidationError{
+					field:  "TypingStopped",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamRequest_Ack:
+		if v == nil {
+			err := ChatStreamRequestValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAck()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamRequestValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamRequest_Resume:
+		if v == nil {
+			err := ChatStreamRequestValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetResume()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Resume",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamRequestValidationError{
+						field:  "Resume",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResume()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamRequestValidationError{
+					field:  "Resume",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return ChatStreamRequestMultiError(errors)
 	}
@@ -554,75 +774,52 @@ var _ interface {
 	ErrorName() string
 } = ChatStreamRequestValidationError{}
 
-// Validate checks the field values on RegisterResponse with the rules defined
+// Validate checks the field values on OutgoingMessage with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *RegisterResponse) Validate() error {
+func (m *OutgoingMessage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RegisterResponse with the rules
+// ValidateAll checks the field values on OutgoingMessage with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RegisterResponseMultiError, or nil if none found.
-func (m *RegisterResponse) ValidateAll() error {
+// OutgoingMessageMultiError, or nil if none found.
+func (m *OutgoingMessage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RegisterResponse) validate(all bool) error {
+func (m *OutgoingMessage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for ToEmail
 
-	// no validation rules for UserId
+	// no validation rules for Content
 
-	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RegisterResponseValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RegisterResponseValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RegisterResponseValidationError{
-				field:  "ExpiresAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for GroupId
+
+	// no validation rules for ReplyToMsgId
+
+	// no validation rules for ClientMsgId
 
 	if len(errors) > 0 {
-		return RegisterResponseMultiError(errors)
+		return OutgoingMessageMultiError(errors)
 	}
 
 	return nil
 }
 
-// RegisterResponseMultiError is an error wrapping multiple validation errors
-// returned by RegisterResponse.ValidateAll() if the designated constraints
+// OutgoingMessageMultiError is an error wrapping multiple validation errors
+// returned by OutgoingMessage.ValidateAll() if the designated constraints
 // aren't met.
-type RegisterResponseMultiError []error
+type OutgoingMessageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RegisterResponseMultiError) Error() string {
+func (m OutgoingMessageMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -631,11 +828,11 @@ func (m RegisterResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RegisterResponseMultiError) AllErrors() []error { return m }
+func (m OutgoingMessageMultiError) AllErrors() []error { return m }
 
-// RegisterResponseValidationError is the validation error returned by
-// RegisterResponse.Validate if the designated constraints aren't met.
-type RegisterResponseValidationError struct {
+// OutgoingMessageValidationError is the validation error returned by
+// OutgoingMessage.Validate if the designated constraints aren't met.
+type OutgoingMessageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -643,22 +840,22 @@ type RegisterResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RegisterResponseValidationError) Field() string { return e.field }
+func (e OutgoingMessageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RegisterResponseValidationError) Reason() string { return e.reason }
+func (e OutgoingMessageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RegisterResponseValidationError) Cause() error { return e.cause }
+func (e OutgoingMessageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RegisterResponseValidationError) Key() bool { return e.key }
+func (e OutgoingMessageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RegisterResponseValidationError) ErrorName() string { return "RegisterResponseValidationError" }
+func (e OutgoingMessageValidationError) ErrorName() string { return "OutgoingMessageValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RegisterResponseValidationError) Error() string {
+func (e OutgoingMessageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -670,14 +867,14 @@ func (e RegisterResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRegisterResponse.%s: %s%s",
+		"invalid %sOutgoingMessage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RegisterResponseValidationError{}
+var _ error = OutgoingMessageValidationError{}
 
 var _ interface {
 	Field() string
@@ -685,77 +882,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RegisterResponseValidationError{}
+} = OutgoingMessageValidationError{}
 
-// Validate checks the field values on LoginResponse with the rules defined in
+// Validate checks the field values on TypingEvent with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LoginResponse) Validate() error {
+func (m *TypingEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// ValidateAll checks the field values on TypingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TypingEventMultiError, or
 // nil if none found.
-func (m *LoginResponse) ValidateAll() error {
+func (m *TypingEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginResponse) validate(all bool) error {
+func (m *TypingEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
-	// no validation rules for UserId
+	// no validation rules for ToEmail
 
-	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LoginResponseValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, LoginResponseValidationError{
-					field:  "ExpiresAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LoginResponseValidationError{
-				field:  "ExpiresAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for GroupId
 
 	if len(errors) > 0 {
-		return LoginResponseMultiError(errors)
+		return TypingEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginResponseMultiError is an error wrapping multiple validation errors
-// returned by LoginResponse.ValidateAll() if the designated constraints
-// aren't met.
-type LoginResponseMultiError []error
+// TypingEventMultiError is an error wrapping multiple validation errors
+// returned by TypingEvent.ValidateAll() if the designated constraints aren't met.
+type TypingEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginResponseMultiError) Error() string {
+func (m TypingEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -764,11 +931,11 @@ func (m LoginResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginResponseMultiError) AllErrors() []error { return m }
+func (m TypingEventMultiError) AllErrors() []error { return m }
 
-// LoginResponseValidationError is the validation error returned by
-// LoginResponse.Validate if the designated constraints aren't met.
-type LoginResponseValidationError struct {
+// TypingEventValidationError is the validation error returned by
+// TypingEvent.Validate if the designated constraints aren't met.
+type TypingEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -776,22 +943,22 @@ type LoginResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginResponseValidationError) Field() string { return e.field }
+func (e TypingEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginResponseValidationError) Reason() string { return e.reason }
+func (e TypingEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginResponseValidationError) Cause() error { return e.cause }
+func (e TypingEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginResponseValidationError) Key() bool { return e.key }
+func (e TypingEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+func (e TypingEventValidationError) ErrorName() string { return "TypingEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginResponseValidationError) Error() string {
+func (e TypingEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -803,14 +970,14 @@ func (e LoginResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginResponse.%s: %s%s",
+		"invalid %sTypingEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginResponseValidationError{}
+var _ error = TypingEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -818,77 +985,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginResponseValidationError{}
+} = TypingEventValidationError{}
 
-// Validate checks the field values on ListChatsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListChatsResponse) Validate() error {
+// Validate checks the field values on AckEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AckEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListChatsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListChatsResponseMultiError, or nil if none found.
-func (m *ListChatsResponse) ValidateAll() error {
+// ValidateAll checks the field values on AckEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AckEventMultiError, or nil
+// if none found.
+func (m *AckEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListChatsResponse) validate(all bool) error {
+func (m *AckEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
-
-	// no validation rules for LastMessage
-
-	if all {
-		switch v := interface{}(m.GetLastMessageAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListChatsResponseValidationError{
-					field:  "LastMessageAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListChatsResponseValidationError{
-					field:  "LastMessageAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListChatsResponseValidationError{
-				field:  "LastMessageAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListChatsResponseMultiError(errors)
+		return AckEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListChatsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListChatsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListChatsResponseMultiError []error
+// AckEventMultiError is an error wrapping multiple validation errors returned
+// by AckEvent.ValidateAll() if the designated constraints aren't met.
+type AckEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListChatsResponseMultiError) Error() string {
+func (m AckEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -897,11 +1030,11 @@ func (m ListChatsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListChatsResponseMultiError) AllErrors() []error { return m }
+func (m AckEventMultiError) AllErrors() []error { return m }
 
-// ListChatsResponseValidationError is the validation error returned by
-// ListChatsResponse.Validate if the designated constraints aren't met.
-type ListChatsResponseValidationError struct {
+// AckEventValidationError is the validation error returned by
+// AckEvent.Validate if the designated constraints aren't met.
+type AckEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -909,24 +1042,22 @@ type ListChatsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListChatsResponseValidationError) Field() string { return e.field }
+func (e AckEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListChatsResponseValidationError) Reason() string { return e.reason }
+func (e AckEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListChatsResponseValidationError) Cause() error { return e.cause }
+func (e AckEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListChatsResponseValidationError) Key() bool { return e.key }
+func (e AckEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListChatsResponseValidationError) ErrorName() string {
-	return "ListChatsResponseValidationError"
-}
+func (e AckEventValidationError) ErrorName() string { return "AckEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListChatsResponseValidationError) Error() string {
+func (e AckEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -938,14 +1069,14 @@ func (e ListChatsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListChatsResponse.%s: %s%s",
+		"invalid %sAckEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListChatsResponseValidationError{}
+var _ error = AckEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -953,24 +1084,10945 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListChatsResponseValidationError{}
+} = AckEventValidationError{}
 
-// Validate checks the field values on GetHistoryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetHistoryResponse) Validate() error {
+// Validate checks the field values on ResumeEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResumeEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetHistoryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetHistoryResponseMultiError, or nil if none found.
-func (m *GetHistoryResponse) ValidateAll() error {
+// ValidateAll checks the field values on ResumeEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResumeEventMultiError, or
+// nil if none found.
+func (m *ResumeEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetHistoryResponse) validate(all bool) error {
+func (m *ResumeEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResumeAfter
+
+	if len(errors) > 0 {
+		return ResumeEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeEventMultiError is an error wrapping multiple validation errors
+// returned by ResumeEvent.ValidateAll() if the designated constraints aren't met.
+type ResumeEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeEventMultiError) AllErrors() []error { return m }
+
+// ResumeEventValidationError is the validation error returned by
+// ResumeEvent.Validate if the designated constraints aren't met.
+type ResumeEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeEventValidationError) ErrorName() string { return "ResumeEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResumeEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeEventValidationError{}
+
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupRequestMultiError, or nil if none found.
+func (m *CreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupRequestValidationError) ErrorName() string {
+	return "CreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupRequestValidationError{}
+
+// Validate checks the field values on AddMemberRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMemberRequestMultiError, or nil if none found.
+func (m *AddMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return AddMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddMemberRequestMultiError is an error wrapping multiple validation errors
+// returned by AddMemberRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddMemberRequestValidationError is the validation error returned by
+// AddMemberRequest.Validate if the designated constraints aren't met.
+type AddMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMemberRequestValidationError) ErrorName() string { return "AddMemberRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberRequestMultiError, or nil if none found.
+func (m *RemoveMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return RemoveMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveMemberRequestValidationError is the validation error returned by
+// RemoveMemberRequest.Validate if the designated constraints aren't met.
+type RemoveMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberRequestValidationError) ErrorName() string {
+	return "RemoveMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberRequestValidationError{}
+
+// Validate checks the field values on LeaveGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupRequestMultiError, or nil if none found.
+func (m *LeaveGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return LeaveGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupRequestMultiError) AllErrors() []error { return m }
+
+// LeaveGroupRequestValidationError is the validation error returned by
+// LeaveGroupRequest.Validate if the designated constraints aren't met.
+type LeaveGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupRequestValidationError) ErrorName() string {
+	return "LeaveGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupRequestValidationError{}
+
+// Validate checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageRequestMultiError, or nil if none found.
+func (m *EditMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return EditMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by EditMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageRequestMultiError) AllErrors() []error { return m }
+
+// EditMessageRequestValidationError is the validation error returned by
+// EditMessageRequest.Validate if the designated constraints aren't met.
+type EditMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageRequestValidationError) ErrorName() string {
+	return "EditMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageRequestValidationError{}
+
+// Validate checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageRequestMultiError, or nil if none found.
+func (m *DeleteMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return DeleteMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMessageRequestValidationError is the validation error returned by
+// DeleteMessageRequest.Validate if the designated constraints aren't met.
+type DeleteMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageRequestValidationError) ErrorName() string {
+	return "DeleteMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageRequestValidationError{}
+
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+// Validate checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceRequestMultiError, or nil if none found.
+func (m *GetPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceRequestValidationError is the validation error returned by
+// GetPresenceRequest.Validate if the designated constraints aren't met.
+type GetPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceRequestValidationError) ErrorName() string {
+	return "GetPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceRequestValidationError{}
+
+// Validate checks the field values on WatchPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPresenceRequestMultiError, or nil if none found.
+func (m *WatchPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WatchPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPresenceRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchPresenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPresenceRequestMultiError) AllErrors() []error { return m }
+
+// WatchPresenceRequestValidationError is the validation error returned by
+// WatchPresenceRequest.Validate if the designated constraints aren't met.
+type WatchPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPresenceRequestValidationError) ErrorName() string {
+	return "WatchPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPresenceRequestValidationError{}
+
+// Validate checks the field values on SetLastSeenVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLastSeenVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLastSeenVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLastSeenVisibilityRequestMultiError, or nil if none found.
+func (m *SetLastSeenVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLastSeenVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Visibility
+
+	if len(errors) > 0 {
+		return SetLastSeenVisibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLastSeenVisibilityRequestMultiError is an error wrapping multiple
+// validation errors returned by SetLastSeenVisibilityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetLastSeenVisibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLastSeenVisibilityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLastSeenVisibilityRequestMultiError) AllErrors() []error { return m }
+
+// SetLastSeenVisibilityRequestValidationError is the validation error returned
+// by SetLastSeenVisibilityRequest.Validate if the designated constraints
+// aren't met.
+type SetLastSeenVisibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLastSeenVisibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLastSeenVisibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLastSeenVisibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLastSeenVisibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLastSeenVisibilityRequestValidationError) ErrorName() string {
+	return "SetLastSeenVisibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLastSeenVisibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLastSeenVisibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLastSeenVisibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLastSeenVisibilityRequestValidationError{}
+
+// Validate checks the field values on ReactRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactRequestMultiError, or
+// nil if none found.
+func (m *ReactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Emoji
+
+	if len(errors) > 0 {
+		return ReactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactRequestMultiError is an error wrapping multiple validation errors
+// returned by ReactRequest.ValidateAll() if the designated constraints aren't met.
+type ReactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactRequestMultiError) AllErrors() []error { return m }
+
+// ReactRequestValidationError is the validation error returned by
+// ReactRequest.Validate if the designated constraints aren't met.
+type ReactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactRequestValidationError) ErrorName() string { return "ReactRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactRequestValidationError{}
+
+// Validate checks the field values on UnreactRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnreactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnreactRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnreactRequestMultiError,
+// or nil if none found.
+func (m *UnreactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnreactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Emoji
+
+	if len(errors) > 0 {
+		return UnreactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnreactRequestMultiError is an error wrapping multiple validation errors
+// returned by UnreactRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnreactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnreactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnreactRequestMultiError) AllErrors() []error { return m }
+
+// UnreactRequestValidationError is the validation error returned by
+// UnreactRequest.Validate if the designated constraints aren't met.
+type UnreactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnreactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnreactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnreactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnreactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnreactRequestValidationError) ErrorName() string { return "UnreactRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnreactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnreactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnreactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnreactRequestValidationError{}
+
+// Validate checks the field values on GetThreadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetThreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetThreadRequestMultiError, or nil if none found.
+func (m *GetThreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetThreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if len(errors) > 0 {
+		return GetThreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetThreadRequestMultiError is an error wrapping multiple validation errors
+// returned by GetThreadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetThreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetThreadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetThreadRequestMultiError) AllErrors() []error { return m }
+
+// GetThreadRequestValidationError is the validation error returned by
+// GetThreadRequest.Validate if the designated constraints aren't met.
+type GetThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadRequestValidationError) ErrorName() string { return "GetThreadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadRequestValidationError{}
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Frame.(type) {
+	case *UploadAttachmentRequest_Metadata:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAttachmentRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadAttachmentRequest_Chunk:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	// no validation rules for Chunk
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on AttachmentMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachmentMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachmentMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachmentMetadataMultiError, or nil if none found.
+func (m *AttachmentMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachmentMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return AttachmentMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMetadataMultiError is an error wrapping multiple validation errors
+// returned by AttachmentMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type AttachmentMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMetadataMultiError) AllErrors() []error { return m }
+
+// AttachmentMetadataValidationError is the validation error returned by
+// AttachmentMetadata.Validate if the designated constraints aren't met.
+type AttachmentMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentMetadataValidationError) ErrorName() string {
+	return "AttachmentMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachmentMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachmentMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentMetadataValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttachmentId
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesRequestMultiError, or nil if none found.
+func (m *SearchMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for WithEmail
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetSentAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchMessagesRequestValidationError{
+					field:  "SentAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchMessagesRequestValidationError{
+					field:  "SentAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchMessagesRequestValidationError{
+				field:  "SentAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSentBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchMessagesRequestValidationError{
+					field:  "SentBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchMessagesRequestValidationError{
+					field:  "SentBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchMessagesRequestValidationError{
+				field:  "SentBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return SearchMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesRequestMultiError) AllErrors() []error { return m }
+
+// SearchMessagesRequestValidationError is the validation error returned by
+// SearchMessagesRequest.Validate if the designated constraints aren't met.
+type SearchMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesRequestValidationError) ErrorName() string {
+	return "SearchMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesRequestValidationError{}
+
+// Validate checks the field values on WatchChatsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchChatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchChatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchChatsRequestMultiError, or nil if none found.
+func (m *WatchChatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchChatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return WatchChatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchChatsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchChatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchChatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchChatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchChatsRequestMultiError) AllErrors() []error { return m }
+
+// WatchChatsRequestValidationError is the validation error returned by
+// WatchChatsRequest.Validate if the designated constraints aren't met.
+type WatchChatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchChatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchChatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchChatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchChatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchChatsRequestValidationError) ErrorName() string {
+	return "WatchChatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchChatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchChatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchChatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchChatsRequestValidationError{}
+
+// Validate checks the field values on ScheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleMessageRequestMultiError, or nil if none found.
+func (m *ScheduleMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToEmail
+
+	// no validation rules for GroupId
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetSendAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleMessageRequestValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleMessageRequestValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleMessageRequestValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by ScheduleMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMessageRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleMessageRequestValidationError is the validation error returned by
+// ScheduleMessageRequest.Validate if the designated constraints aren't met.
+type ScheduleMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageRequestValidationError) ErrorName() string {
+	return "ScheduleMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageRequestValidationError{}
+
+// Validate checks the field values on ListScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledRequestMultiError, or nil if none found.
+func (m *ListScheduledRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListScheduledRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledRequestMultiError is an error wrapping multiple validation
+// errors returned by ListScheduledRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListScheduledRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledRequestMultiError) AllErrors() []error { return m }
+
+// ListScheduledRequestValidationError is the validation error returned by
+// ListScheduledRequest.Validate if the designated constraints aren't met.
+type ListScheduledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledRequestValidationError) ErrorName() string {
+	return "ListScheduledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelScheduledRequestMultiError, or nil if none found.
+func (m *CancelScheduledRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledId
+
+	if len(errors) > 0 {
+		return CancelScheduledRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelScheduledRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelScheduledRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledRequestValidationError is the validation error returned by
+// CancelScheduledRequest.Validate if the designated constraints aren't met.
+type CancelScheduledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledRequestValidationError) ErrorName() string {
+	return "CancelScheduledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledRequestValidationError{}
+
+// Validate checks the field values on SetMessageExpiryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMessageExpiryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMessageExpiryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMessageExpiryRequestMultiError, or nil if none found.
+func (m *SetMessageExpiryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMessageExpiryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithEmail
+
+	// no validation rules for Expiry
+
+	if len(errors) > 0 {
+		return SetMessageExpiryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMessageExpiryRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMessageExpiryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMessageExpiryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMessageExpiryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMessageExpiryRequestMultiError) AllErrors() []error { return m }
+
+// SetMessageExpiryRequestValidationError is the validation error returned by
+// SetMessageExpiryRequest.Validate if the designated constraints aren't met.
+type SetMessageExpiryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMessageExpiryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMessageExpiryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMessageExpiryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMessageExpiryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMessageExpiryRequestValidationError) ErrorName() string {
+	return "SetMessageExpiryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMessageExpiryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMessageExpiryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMessageExpiryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMessageExpiryRequestValidationError{}
+
+// Validate checks the field values on PinMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageRequestMultiError, or nil if none found.
+func (m *PinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if len(errors) > 0 {
+		return PinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by PinMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageRequestMultiError) AllErrors() []error { return m }
+
+// PinMessageRequestValidationError is the validation error returned by
+// PinMessageRequest.Validate if the designated constraints aren't met.
+type PinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageRequestValidationError) ErrorName() string {
+	return "PinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageRequestValidationError{}
+
+// Validate checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageRequestMultiError, or nil if none found.
+func (m *UnpinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if len(errors) > 0 {
+		return UnpinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageRequestMultiError) AllErrors() []error { return m }
+
+// UnpinMessageRequestValidationError is the validation error returned by
+// UnpinMessageRequest.Validate if the designated constraints aren't met.
+type UnpinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageRequestValidationError) ErrorName() string {
+	return "UnpinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageRequestValidationError{}
+
+// Validate checks the field values on ListPinnedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedRequestMultiError, or nil if none found.
+func (m *ListPinnedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithEmail
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return ListPinnedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPinnedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPinnedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedRequestMultiError) AllErrors() []error { return m }
+
+// ListPinnedRequestValidationError is the validation error returned by
+// ListPinnedRequest.Validate if the designated constraints aren't met.
+type ListPinnedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedRequestValidationError) ErrorName() string {
+	return "ListPinnedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedRequestValidationError{}
+
+// Validate checks the field values on ForwardMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForwardMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForwardMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForwardMessageRequestMultiError, or nil if none found.
+func (m *ForwardMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForwardMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if len(errors) > 0 {
+		return ForwardMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForwardMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by ForwardMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForwardMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForwardMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForwardMessageRequestMultiError) AllErrors() []error { return m }
+
+// ForwardMessageRequestValidationError is the validation error returned by
+// ForwardMessageRequest.Validate if the designated constraints aren't met.
+type ForwardMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForwardMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForwardMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForwardMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForwardMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForwardMessageRequestValidationError) ErrorName() string {
+	return "ForwardMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForwardMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForwardMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForwardMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForwardMessageRequestValidationError{}
+
+// Validate checks the field values on BlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserRequestMultiError, or nil if none found.
+func (m *BlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return BlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserRequestMultiError) AllErrors() []error { return m }
+
+// BlockUserRequestValidationError is the validation error returned by
+// BlockUserRequest.Validate if the designated constraints aren't met.
+type BlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserRequestValidationError) ErrorName() string { return "BlockUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserRequestValidationError{}
+
+// Validate checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserRequestMultiError, or nil if none found.
+func (m *UnblockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return UnblockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnblockUserRequestValidationError is the validation error returned by
+// UnblockUserRequest.Validate if the designated constraints aren't met.
+type UnblockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserRequestValidationError) ErrorName() string {
+	return "UnblockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserRequestValidationError{}
+
+// Validate checks the field values on ListBlockedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedRequestMultiError, or nil if none found.
+func (m *ListBlockedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListBlockedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlockedRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBlockedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBlockedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedRequestMultiError) AllErrors() []error { return m }
+
+// ListBlockedRequestValidationError is the validation error returned by
+// ListBlockedRequest.Validate if the designated constraints aren't met.
+type ListBlockedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedRequestValidationError) ErrorName() string {
+	return "ListBlockedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedRequestValidationError{}
+
+// Validate checks the field values on ListMessageRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessageRequestsRequestMultiError, or nil if none found.
+func (m *ListMessageRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListMessageRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMessageRequestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMessageRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListMessageRequestsRequestValidationError is the validation error returned
+// by ListMessageRequestsRequest.Validate if the designated constraints aren't met.
+type ListMessageRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageRequestsRequestValidationError) ErrorName() string {
+	return "ListMessageRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageRequestsRequestValidationError{}
+
+// Validate checks the field values on RespondToMessageRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RespondToMessageRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RespondToMessageRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RespondToMessageRequestRequestMultiError, or nil if none found.
+func (m *RespondToMessageRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RespondToMessageRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromEmail
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return RespondToMessageRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RespondToMessageRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by RespondToMessageRequestRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RespondToMessageRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RespondToMessageRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RespondToMessageRequestRequestMultiError) AllErrors() []error { return m }
+
+// RespondToMessageRequestRequestValidationError is the validation error
+// returned by RespondToMessageRequestRequest.Validate if the designated
+// constraints aren't met.
+type RespondToMessageRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RespondToMessageRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RespondToMessageRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RespondToMessageRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RespondToMessageRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RespondToMessageRequestRequestValidationError) ErrorName() string {
+	return "RespondToMessageRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RespondToMessageRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRespondToMessageRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RespondToMessageRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RespondToMessageRequestRequestValidationError{}
+
+// Validate checks the field values on GetProfileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileRequestMultiError, or nil if none found.
+func (m *GetProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return GetProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProfileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetProfileRequestValidationError is the validation error returned by
+// GetProfileRequest.Validate if the designated constraints aren't met.
+type GetProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileRequestValidationError) ErrorName() string {
+	return "GetProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileRequestValidationError{}
+
+// Validate checks the field values on UpdateProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProfileRequestMultiError, or nil if none found.
+func (m *UpdateProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.DisplayName != nil {
+		// no validation rules for DisplayName
+
+	}
+
+	if m.AvatarAttachmentId != nil {
+		// no validation rules for AvatarAttachmentId
+
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+
+	}
+
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProfileRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProfileRequestValidationError is the validation error returned by
+// UpdateProfileRequest.Validate if the designated constraints aren't met.
+type UpdateProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProfileRequestValidationError) ErrorName() string {
+	return "UpdateProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProfileRequestValidationError{}
+
+// Validate checks the field values on BatchGetProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetProfilesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetProfilesRequestMultiError, or nil if none found.
+func (m *BatchGetProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BatchGetProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetProfilesRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProfilesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProfilesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProfilesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetProfilesRequestValidationError is the validation error returned by
+// BatchGetProfilesRequest.Validate if the designated constraints aren't met.
+type BatchGetProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProfilesRequestValidationError) ErrorName() string {
+	return "BatchGetProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProfilesRequestValidationError{}
+
+// Validate checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return SearchUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchUsersRequestValidationError{}
+
+// Validate checks the field values on SetDiscoverableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDiscoverableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDiscoverableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDiscoverableRequestMultiError, or nil if none found.
+func (m *SetDiscoverableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDiscoverableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Discoverable
+
+	if len(errors) > 0 {
+		return SetDiscoverableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDiscoverableRequestMultiError is an error wrapping multiple validation
+// errors returned by SetDiscoverableRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetDiscoverableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDiscoverableRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDiscoverableRequestMultiError) AllErrors() []error { return m }
+
+// SetDiscoverableRequestValidationError is the validation error returned by
+// SetDiscoverableRequest.Validate if the designated constraints aren't met.
+type SetDiscoverableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDiscoverableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDiscoverableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDiscoverableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDiscoverableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDiscoverableRequestValidationError) ErrorName() string {
+	return "SetDiscoverableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDiscoverableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDiscoverableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDiscoverableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDiscoverableRequestValidationError{}
+
+// Validate checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenRequestMultiError, or nil if none found.
+func (m *RefreshTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return RefreshTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshTokenRequestMultiError) AllErrors() []error { return m }
+
+// RefreshTokenRequestValidationError is the validation error returned by
+// RefreshTokenRequest.Validate if the designated constraints aren't met.
+type RefreshTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshTokenRequestValidationError) ErrorName() string {
+	return "RefreshTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshTokenRequestValidationError{}
+
+// Validate checks the field values on RegisterResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegisterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterResponseMultiError, or nil if none found.
+func (m *RegisterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RegisterResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RegisterResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RegisterResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefreshToken
+
+	if all {
+		switch v := interface{}(m.GetRefreshTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RegisterResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RegisterResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RegisterResponseValidationError{
+				field:  "RefreshTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RegisterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterResponseMultiError is an error wrapping multiple validation errors
+// returned by RegisterResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RegisterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterResponseMultiError) AllErrors() []error { return m }
+
+// RegisterResponseValidationError is the validation error returned by
+// RegisterResponse.Validate if the designated constraints aren't met.
+type RegisterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterResponseValidationError) ErrorName() string { return "RegisterResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegisterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterResponseValidationError{}
+
+// Validate checks the field values on LoginResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginResponseMultiError, or
+// nil if none found.
+func (m *LoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoginResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefreshToken
+
+	if all {
+		switch v := interface{}(m.GetRefreshTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoginResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoginResponseValidationError{
+				field:  "RefreshTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginResponseMultiError is an error wrapping multiple validation errors
+// returned by LoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginResponseMultiError) AllErrors() []error { return m }
+
+// LoginResponseValidationError is the validation error returned by
+// LoginResponse.Validate if the designated constraints aren't met.
+type LoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginResponseValidationError) ErrorName() string { return "LoginResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginResponseValidationError{}
+
+// Validate checks the field values on ListChatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListChatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChatsResponseMultiError, or nil if none found.
+func (m *ListChatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for LastMessage
+
+	if all {
+		switch v := interface{}(m.GetLastMessageAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListChatsResponseValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListChatsResponseValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListChatsResponseValidationError{
+				field:  "LastMessageAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupId
+
+	// no validation rules for GroupName
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for MessageExpiry
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListChatsResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListChatsResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListChatsResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListChatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChatsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListChatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListChatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChatsResponseMultiError) AllErrors() []error { return m }
+
+// ListChatsResponseValidationError is the validation error returned by
+// ListChatsResponse.Validate if the designated constraints aren't met.
+type ListChatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChatsResponseValidationError) ErrorName() string {
+	return "ListChatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChatsResponseValidationError{}
+
+// Validate checks the field values on GetHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHistoryResponseMultiError, or nil if none found.
+func (m *GetHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	// no validation rules for ToEmail
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryResponseValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryResponseValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
+	// no validation rules for Read
+
+	// no validation rules for DeliveryState
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetHistoryResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplyTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplyTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryResponseValidationError{
+				field:  "ReplyTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetHistoryResponseValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetHistoryResponseValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	// no validation rules for NextCursor
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for System
+
+	if all {
+		switch v := interface{}(m.GetForwardedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryResponseValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForwardedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryResponseValidationError{
+				field:  "ForwardedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return GetHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHistoryResponseMultiError is an error wrapping multiple validation errors
+// returned by GetHistoryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetHistoryResponseValidationError is the validation error returned by
+// GetHistoryResponse.Validate if the designated constraints aren't met.
+type GetHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHistoryResponseValidationError) ErrorName() string {
+	return "GetHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHistoryResponseValidationError{}
+
+// Validate checks the field values on ChatStreamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChatStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatStreamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChatStreamResponseMultiError, or nil if none found.
+func (m *ChatStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatStreamResponseValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatStreamResponseValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatStreamResponseValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatStreamResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatStreamResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatStreamResponseValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
+	switch v := m.Event.(type) {
+	case *ChatStreamResponse_Message:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMessage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_TypingStarted:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTypingStarted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "TypingStarted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "TypingStarted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTypingStarted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "TypingStarted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_TypingStopped:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTypingStopped()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "TypingStopped",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "TypingStopped",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTypingStopped()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "TypingStopped",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_ReadReceipt:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReadReceipt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "ReadReceipt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "ReadReceipt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReadReceipt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "ReadReceipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_DeliveryReceipt:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeliveryReceipt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "DeliveryReceipt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "DeliveryReceipt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeliveryReceipt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "DeliveryReceipt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_Reaction:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReaction()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Reaction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Reaction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReaction()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "Reaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_ResumeComplete:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetResumeComplete()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "ResumeComplete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "ResumeComplete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResumeComplete()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "ResumeComplete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_Pin:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPin()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Pin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "Pin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPin()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "Pin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChatStreamResponse_SendError:
+		if v == nil {
+			err := ChatStreamResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSendError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "SendError",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamResponseValidationError{
+						field:  "SendError",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamResponseValidationError{
+					field:  "SendError",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ChatStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatStreamResponseMultiError is an error wrapping multiple validation errors
+// returned by ChatStreamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ChatStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatStreamResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatStreamResponseMultiError) AllErrors() []error { return m }
+
+// ChatStreamResponseValidationError is the validation error returned by
+// ChatStreamResponse.Validate if the designated constraints aren't met.
+type ChatStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatStreamResponseValidationError) ErrorName() string {
+	return "ChatStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChatStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatStreamResponseValidationError{}
+
+// Validate checks the field values on ChatMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatMessageMultiError, or
+// nil if none found.
+func (m *ChatMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
+	if all {
+		switch v := interface{}(m.GetReplyTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplyTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "ReplyTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatMessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatMessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatMessageValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	// no validation rules for ClientMsgId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for System
+
+	if all {
+		switch v := interface{}(m.GetForwardedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatMessageValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForwardedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatMessageValidationError{
+				field:  "ForwardedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return ChatMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatMessageMultiError is an error wrapping multiple validation errors
+// returned by ChatMessage.ValidateAll() if the designated constraints aren't met.
+type ChatMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatMessageMultiError) AllErrors() []error { return m }
+
+// ChatMessageValidationError is the validation error returned by
+// ChatMessage.Validate if the designated constraints aren't met.
+type ChatMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatMessageValidationError) ErrorName() string { return "ChatMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatMessageValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttachmentId
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on QuotedMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotedMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotedMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotedMessageMultiError, or
+// nil if none found.
+func (m *QuotedMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotedMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return QuotedMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotedMessageMultiError is an error wrapping multiple validation errors
+// returned by QuotedMessage.ValidateAll() if the designated constraints
+// aren't met.
+type QuotedMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotedMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotedMessageMultiError) AllErrors() []error { return m }
+
+// QuotedMessageValidationError is the validation error returned by
+// QuotedMessage.Validate if the designated constraints aren't met.
+type QuotedMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotedMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotedMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotedMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotedMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotedMessageValidationError) ErrorName() string { return "QuotedMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotedMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotedMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotedMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotedMessageValidationError{}
+
+// Validate checks the field values on ForwardedFrom with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForwardedFrom) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForwardedFrom with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForwardedFromMultiError, or
+// nil if none found.
+func (m *ForwardedFrom) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForwardedFrom) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForwardedFromValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ForwardedFromValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForwardedFromValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForwardedFromMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForwardedFromMultiError is an error wrapping multiple validation errors
+// returned by ForwardedFrom.ValidateAll() if the designated constraints
+// aren't met.
+type ForwardedFromMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForwardedFromMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForwardedFromMultiError) AllErrors() []error { return m }
+
+// ForwardedFromValidationError is the validation error returned by
+// ForwardedFrom.Validate if the designated constraints aren't met.
+type ForwardedFromValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForwardedFromValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForwardedFromValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForwardedFromValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForwardedFromValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForwardedFromValidationError) ErrorName() string { return "ForwardedFromValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForwardedFromValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForwardedFrom.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForwardedFromValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForwardedFromValidationError{}
+
+// Validate checks the field values on TypingIndicator with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TypingIndicator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TypingIndicator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TypingIndicatorMultiError, or nil if none found.
+func (m *TypingIndicator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TypingIndicator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromEmail
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return TypingIndicatorMultiError(errors)
+	}
+
+	return nil
+}
+
+// TypingIndicatorMultiError is an error wrapping multiple validation errors
+// returned by TypingIndicator.ValidateAll() if the designated constraints
+// aren't met.
+type TypingIndicatorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TypingIndicatorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TypingIndicatorMultiError) AllErrors() []error { return m }
+
+// TypingIndicatorValidationError is the validation error returned by
+// TypingIndicator.Validate if the designated constraints aren't met.
+type TypingIndicatorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypingIndicatorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypingIndicatorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypingIndicatorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypingIndicatorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypingIndicatorValidationError) ErrorName() string { return "TypingIndicatorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypingIndicatorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTypingIndicator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TypingIndicatorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypingIndicatorValidationError{}
+
+// Validate checks the field values on DeliveryReceipt with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeliveryReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliveryReceipt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliveryReceiptMultiError, or nil if none found.
+func (m *DeliveryReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliveryReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecipientEmail
+
+	// no validation rules for MsgId
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeliveryReceiptValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeliveryReceiptValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeliveryReceiptValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeliveryReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliveryReceiptMultiError is an error wrapping multiple validation errors
+// returned by DeliveryReceipt.ValidateAll() if the designated constraints
+// aren't met.
+type DeliveryReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliveryReceiptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliveryReceiptMultiError) AllErrors() []error { return m }
+
+// DeliveryReceiptValidationError is the validation error returned by
+// DeliveryReceipt.Validate if the designated constraints aren't met.
+type DeliveryReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliveryReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliveryReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliveryReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliveryReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliveryReceiptValidationError) ErrorName() string { return "DeliveryReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeliveryReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliveryReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliveryReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliveryReceiptValidationError{}
+
+// Validate checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadReceiptMultiError, or
+// nil if none found.
+func (m *ReadReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReaderEmail
+
+	// no validation rules for MsgId
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetReadUpTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadReceiptValidationError{
+					field:  "ReadUpTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadReceiptValidationError{
+					field:  "ReadUpTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadUpTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadReceiptValidationError{
+				field:  "ReadUpTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadReceiptValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadReceiptValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadReceiptValidationError{
+				field:  "ReadAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadReceiptMultiError is an error wrapping multiple validation errors
+// returned by ReadReceipt.ValidateAll() if the designated constraints aren't met.
+type ReadReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadReceiptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadReceiptMultiError) AllErrors() []error { return m }
+
+// ReadReceiptValidationError is the validation error returned by
+// ReadReceipt.Validate if the designated constraints aren't met.
+type ReadReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadReceiptValidationError) ErrorName() string { return "ReadReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadReceiptValidationError{}
+
+// Validate checks the field values on ReactionEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactionEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionEventMultiError, or
+// nil if none found.
+func (m *ReactionEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Email
+
+	// no validation rules for Emoji
+
+	// no validation rules for Added
+
+	if len(errors) > 0 {
+		return ReactionEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionEventMultiError is an error wrapping multiple validation errors
+// returned by ReactionEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionEventMultiError) AllErrors() []error { return m }
+
+// ReactionEventValidationError is the validation error returned by
+// ReactionEvent.Validate if the designated constraints aren't met.
+type ReactionEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionEventValidationError) ErrorName() string { return "ReactionEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionEventValidationError{}
+
+// Validate checks the field values on PinEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PinEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PinEventMultiError, or nil
+// if none found.
+func (m *PinEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Email
+
+	// no validation rules for Pinned
+
+	if len(errors) > 0 {
+		return PinEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinEventMultiError is an error wrapping multiple validation errors returned
+// by PinEvent.ValidateAll() if the designated constraints aren't met.
+type PinEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinEventMultiError) AllErrors() []error { return m }
+
+// PinEventValidationError is the validation error returned by
+// PinEvent.Validate if the designated constraints aren't met.
+type PinEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinEventValidationError) ErrorName() string { return "PinEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PinEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinEventValidationError{}
+
+// Validate checks the field values on SendError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendErrorMultiError, or nil
+// if none found.
+func (m *SendError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToEmail
+
+	// no validation rules for GroupId
+
+	// no validation rules for ClientMsgId
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SendErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendErrorMultiError is an error wrapping multiple validation errors returned
+// by SendError.ValidateAll() if the designated constraints aren't met.
+type SendErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendErrorMultiError) AllErrors() []error { return m }
+
+// SendErrorValidationError is the validation error returned by
+// SendError.Validate if the designated constraints aren't met.
+type SendErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendErrorValidationError) ErrorName() string { return "SendErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendErrorValidationError{}
+
+// Validate checks the field values on ResumeComplete with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResumeComplete) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeComplete with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResumeCompleteMultiError,
+// or nil if none found.
+func (m *ResumeComplete) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeComplete) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Replayed
+
+	if len(errors) > 0 {
+		return ResumeCompleteMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCompleteMultiError is an error wrapping multiple validation errors
+// returned by ResumeComplete.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeCompleteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCompleteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCompleteMultiError) AllErrors() []error { return m }
+
+// ResumeCompleteValidationError is the validation error returned by
+// ResumeComplete.Validate if the designated constraints aren't met.
+type ResumeCompleteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCompleteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCompleteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCompleteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCompleteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCompleteValidationError) ErrorName() string { return "ResumeCompleteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResumeCompleteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeComplete.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCompleteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCompleteValidationError{}
+
+// Validate checks the field values on ReactionCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactionCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionCountMultiError, or
+// nil if none found.
+func (m *ReactionCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Emoji
+
+	// no validation rules for Count
+
+	// no validation rules for Reacted
+
+	if len(errors) > 0 {
+		return ReactionCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionCountMultiError is an error wrapping multiple validation errors
+// returned by ReactionCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionCountMultiError) AllErrors() []error { return m }
+
+// ReactionCountValidationError is the validation error returned by
+// ReactionCount.Validate if the designated constraints aren't met.
+type ReactionCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionCountValidationError) ErrorName() string { return "ReactionCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionCountValidationError{}
+
+// Validate checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Group) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GroupMultiError, or nil if none found.
+func (m *Group) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Group) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerEmail
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GroupValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMultiError is an error wrapping multiple validation errors returned by
+// Group.ValidateAll() if the designated constraints aren't met.
+type GroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMultiError) AllErrors() []error { return m }
+
+// GroupValidationError is the validation error returned by Group.Validate if
+// the designated constraints aren't met.
+type GroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupValidationError) ErrorName() string { return "GroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupValidationError{}
+
+// Validate checks the field values on CreateGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupResponseMultiError, or nil if none found.
+func (m *CreateGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateGroupResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateGroupResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateGroupResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateGroupResponseValidationError is the validation error returned by
+// CreateGroupResponse.Validate if the designated constraints aren't met.
+type CreateGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupResponseValidationError) ErrorName() string {
+	return "CreateGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupResponseValidationError{}
+
+// Validate checks the field values on AddMemberResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMemberResponseMultiError, or nil if none found.
+func (m *AddMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddMemberResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddMemberResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddMemberResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddMemberResponseMultiError is an error wrapping multiple validation errors
+// returned by AddMemberResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddMemberResponseValidationError is the validation error returned by
+// AddMemberResponse.Validate if the designated constraints aren't met.
+type AddMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMemberResponseValidationError) ErrorName() string {
+	return "AddMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberResponseMultiError, or nil if none found.
+func (m *RemoveMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveMemberResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveMemberResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveMemberResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveMemberResponseValidationError is the validation error returned by
+// RemoveMemberResponse.Validate if the designated constraints aren't met.
+type RemoveMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberResponseValidationError) ErrorName() string {
+	return "RemoveMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberResponseValidationError{}
+
+// Validate checks the field values on LeaveGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupResponseMultiError, or nil if none found.
+func (m *LeaveGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LeaveGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupResponseMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupResponseMultiError) AllErrors() []error { return m }
+
+// LeaveGroupResponseValidationError is the validation error returned by
+// LeaveGroupResponse.Validate if the designated constraints aren't met.
+type LeaveGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupResponseValidationError) ErrorName() string {
+	return "LeaveGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupResponseValidationError{}
+
+// Validate checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageResponseMultiError, or nil if none found.
+func (m *EditMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditMessageResponseValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by EditMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EditMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageResponseMultiError) AllErrors() []error { return m }
+
+// EditMessageResponseValidationError is the validation error returned by
+// EditMessageResponse.Validate if the designated constraints aren't met.
+type EditMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageResponseValidationError) ErrorName() string {
+	return "EditMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageResponseValidationError{}
+
+// Validate checks the field values on DeleteMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageResponseMultiError, or nil if none found.
+func (m *DeleteMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return DeleteMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageResponseMultiError) AllErrors() []error { return m }
+
+// DeleteMessageResponseValidationError is the validation error returned by
+// DeleteMessageResponse.Validate if the designated constraints aren't met.
+type DeleteMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageResponseValidationError) ErrorName() string {
+	return "DeleteMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageResponseValidationError{}
+
+// Validate checks the field values on MarkReadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadResponseMultiError, or nil if none found.
+func (m *MarkReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	if all {
+		switch v := interface{}(m.GetReadAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarkReadResponseValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarkReadResponseValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarkReadResponseValidationError{
+				field:  "ReadAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarkReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadResponseMultiError is an error wrapping multiple validation errors
+// returned by MarkReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkReadResponseValidationError is the validation error returned by
+// MarkReadResponse.Validate if the designated constraints aren't met.
+type MarkReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadResponseValidationError) ErrorName() string { return "MarkReadResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadResponseValidationError{}
+
+// Validate checks the field values on Presence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Presence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Presence with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceMultiError, or nil
+// if none found.
+func (m *Presence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Presence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for Online
+
+	if all {
+		switch v := interface{}(m.GetLastSeen()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeen()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceValidationError{
+				field:  "LastSeen",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PresenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceMultiError is an error wrapping multiple validation errors returned
+// by Presence.ValidateAll() if the designated constraints aren't met.
+type PresenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceMultiError) AllErrors() []error { return m }
+
+// PresenceValidationError is the validation error returned by
+// Presence.Validate if the designated constraints aren't met.
+type PresenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceValidationError) ErrorName() string { return "PresenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceValidationError{}
+
+// Validate checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceResponseMultiError, or nil if none found.
+func (m *GetPresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPresences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPresenceResponseValidationError{
+					field:  fmt.Sprintf("Presences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceResponseMultiError) AllErrors() []error { return m }
+
+// GetPresenceResponseValidationError is the validation error returned by
+// GetPresenceResponse.Validate if the designated constraints aren't met.
+type GetPresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceResponseValidationError) ErrorName() string {
+	return "GetPresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceResponseValidationError{}
+
+// Validate checks the field values on WatchPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchPresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPresenceResponseMultiError, or nil if none found.
+func (m *WatchPresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPresence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPresenceResponseValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPresenceResponseValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPresenceResponseValidationError{
+				field:  "Presence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchPresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchPresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchPresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchPresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPresenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPresenceResponseMultiError) AllErrors() []error { return m }
+
+// WatchPresenceResponseValidationError is the validation error returned by
+// WatchPresenceResponse.Validate if the designated constraints aren't met.
+type WatchPresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPresenceResponseValidationError) ErrorName() string {
+	return "WatchPresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchPresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPresenceResponseValidationError{}
+
+// Validate checks the field values on SetLastSeenVisibilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLastSeenVisibilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLastSeenVisibilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetLastSeenVisibilityResponseMultiError, or nil if none found.
+func (m *SetLastSeenVisibilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLastSeenVisibilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Visibility
+
+	if len(errors) > 0 {
+		return SetLastSeenVisibilityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLastSeenVisibilityResponseMultiError is an error wrapping multiple
+// validation errors returned by SetLastSeenVisibilityResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetLastSeenVisibilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLastSeenVisibilityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLastSeenVisibilityResponseMultiError) AllErrors() []error { return m }
+
+// SetLastSeenVisibilityResponseValidationError is the validation error
+// returned by SetLastSeenVisibilityResponse.Validate if the designated
+// constraints aren't met.
+type SetLastSeenVisibilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLastSeenVisibilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLastSeenVisibilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLastSeenVisibilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLastSeenVisibilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLastSeenVisibilityResponseValidationError) ErrorName() string {
+	return "SetLastSeenVisibilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLastSeenVisibilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLastSeenVisibilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLastSeenVisibilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLastSeenVisibilityResponseValidationError{}
+
+// Validate checks the field values on ReactResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactResponseMultiError, or
+// nil if none found.
+func (m *ReactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReactResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReactResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReactResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactResponseMultiError is an error wrapping multiple validation errors
+// returned by ReactResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactResponseMultiError) AllErrors() []error { return m }
+
+// ReactResponseValidationError is the validation error returned by
+// ReactResponse.Validate if the designated constraints aren't met.
+type ReactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactResponseValidationError) ErrorName() string { return "ReactResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactResponseValidationError{}
+
+// Validate checks the field values on UnreactResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnreactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnreactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnreactResponseMultiError, or nil if none found.
+func (m *UnreactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnreactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UnreactResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UnreactResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UnreactResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnreactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnreactResponseMultiError is an error wrapping multiple validation errors
+// returned by UnreactResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnreactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnreactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnreactResponseMultiError) AllErrors() []error { return m }
+
+// UnreactResponseValidationError is the validation error returned by
+// UnreactResponse.Validate if the designated constraints aren't met.
+type UnreactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnreactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnreactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnreactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnreactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnreactResponseValidationError) ErrorName() string { return "UnreactResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnreactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnreactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnreactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnreactResponseValidationError{}
+
+// Validate checks the field values on GetThreadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetThreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetThreadResponseMultiError, or nil if none found.
+func (m *GetThreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetThreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetThreadResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetThreadResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetThreadResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetThreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetThreadResponseMultiError is an error wrapping multiple validation errors
+// returned by GetThreadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetThreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetThreadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetThreadResponseMultiError) AllErrors() []error { return m }
+
+// GetThreadResponseValidationError is the validation error returned by
+// GetThreadResponse.Validate if the designated constraints aren't met.
+type GetThreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetThreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetThreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetThreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetThreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetThreadResponseValidationError) ErrorName() string {
+	return "GetThreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetThreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetThreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetThreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetThreadResponseValidationError{}
+
+// Validate checks the field values on UploadAttachmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentResponseMultiError, or nil if none found.
+func (m *UploadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentResponseValidationError is the validation error returned by
+// UploadAttachmentResponse.Validate if the designated constraints aren't met.
+type UploadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentResponseValidationError) ErrorName() string {
+	return "UploadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentResponseValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Frame.(type) {
+	case *DownloadAttachmentResponse_Attachment:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAttachment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Attachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadAttachmentResponse_Chunk:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Frame",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	// no validation rules for Chunk
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
+
+// Validate checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesResponseMultiError, or nil if none found.
+func (m *SearchMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMessagesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return SearchMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesResponseMultiError) AllErrors() []error { return m }
+
+// SearchMessagesResponseValidationError is the validation error returned by
+// SearchMessagesResponse.Validate if the designated constraints aren't met.
+type SearchMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesResponseValidationError) ErrorName() string {
+	return "SearchMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesResponseValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for FromEmail
+
+	// no validation rules for ToEmail
+
+	// no validation rules for GroupId
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Snippet
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchResultValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Highlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on WatchChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchChatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchChatsResponseMultiError, or nil if none found.
+func (m *WatchChatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchChatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Event.(type) {
+	case *WatchChatsResponse_Chat:
+		if v == nil {
+			err := WatchChatsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChat()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchChatsResponseValidationError{
+						field:  "Chat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchChatsResponseValidationError{
+						field:  "Chat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchChatsResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *WatchChatsResponse_RemovedGroupId:
+		if v == nil {
+			err := WatchChatsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	// no validation rules for RemovedGroupId
+
+	case *WatchChatsResponse_Synced:
+		if v == nil {
+			err := WatchChatsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSynced()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchChatsResponseValidationError{
+						field:  "Synced",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchChatsResponseValidationError{
+						field:  "Synced",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSynced()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchChatsResponseValidationError{
+					field:  "Synced",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return WatchChatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchChatsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchChatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchChatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchChatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchChatsResponseMultiError) AllErrors() []error { return m }
+
+// WatchChatsResponseValidationError is the validation error returned by
+// WatchChatsResponse.Validate if the designated constraints aren't met.
+type WatchChatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchChatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchChatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchChatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchChatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchChatsResponseValidationError) ErrorName() string {
+	return "WatchChatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchChatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchChatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchChatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchChatsResponseValidationError{}
+
+// Validate checks the field values on ChatsSynced with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatsSynced) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatsSynced with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatsSyncedMultiError, or
+// nil if none found.
+func (m *ChatsSynced) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatsSynced) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChatsSyncedMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatsSyncedMultiError is an error wrapping multiple validation errors
+// returned by ChatsSynced.ValidateAll() if the designated constraints aren't met.
+type ChatsSyncedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatsSyncedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatsSyncedMultiError) AllErrors() []error { return m }
+
+// ChatsSyncedValidationError is the validation error returned by
+// ChatsSynced.Validate if the designated constraints aren't met.
+type ChatsSyncedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatsSyncedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatsSyncedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatsSyncedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatsSyncedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatsSyncedValidationError) ErrorName() string { return "ChatsSyncedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatsSyncedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatsSynced.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatsSyncedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatsSyncedValidationError{}
+
+// Validate checks the field values on ScheduledMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScheduledMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduledMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduledMessageMultiError, or nil if none found.
+func (m *ScheduledMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduledMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledId
+
+	// no validation rules for ToEmail
+
+	// no validation rules for GroupId
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetSendAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledMessageValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledMessageValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledMessageValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledMessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduledMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduledMessageMultiError is an error wrapping multiple validation errors
+// returned by ScheduledMessage.ValidateAll() if the designated constraints
+// aren't met.
+type ScheduledMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduledMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduledMessageMultiError) AllErrors() []error { return m }
+
+// ScheduledMessageValidationError is the validation error returned by
+// ScheduledMessage.Validate if the designated constraints aren't met.
+type ScheduledMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledMessageValidationError) ErrorName() string { return "ScheduledMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduledMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledMessageValidationError{}
+
+// Validate checks the field values on ScheduleMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleMessageResponseMultiError, or nil if none found.
+func (m *ScheduleMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScheduled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleMessageResponseValidationError{
+					field:  "Scheduled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleMessageResponseValidationError{
+					field:  "Scheduled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleMessageResponseValidationError{
+				field:  "Scheduled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by ScheduleMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMessageResponseMultiError) AllErrors() []error { return m }
+
+// ScheduleMessageResponseValidationError is the validation error returned by
+// ScheduleMessageResponse.Validate if the designated constraints aren't met.
+type ScheduleMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageResponseValidationError) ErrorName() string {
+	return "ScheduleMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageResponseValidationError{}
+
+// Validate checks the field values on ListScheduledResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledResponseMultiError, or nil if none found.
+func (m *ListScheduledResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScheduled() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScheduledResponseValidationError{
+						field:  fmt.Sprintf("Scheduled[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScheduledResponseValidationError{
+						field:  fmt.Sprintf("Scheduled[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScheduledResponseValidationError{
+					field:  fmt.Sprintf("Scheduled[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledResponseMultiError is an error wrapping multiple validation
+// errors returned by ListScheduledResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListScheduledResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledResponseMultiError) AllErrors() []error { return m }
+
+// ListScheduledResponseValidationError is the validation error returned by
+// ListScheduledResponse.Validate if the designated constraints aren't met.
+type ListScheduledResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledResponseValidationError) ErrorName() string {
+	return "ListScheduledResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledResponseValidationError{}
+
+// Validate checks the field values on CancelScheduledResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelScheduledResponseMultiError, or nil if none found.
+func (m *CancelScheduledResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledId
+
+	if len(errors) > 0 {
+		return CancelScheduledResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelScheduledResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelScheduledResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledResponseMultiError) AllErrors() []error { return m }
+
+// CancelScheduledResponseValidationError is the validation error returned by
+// CancelScheduledResponse.Validate if the designated constraints aren't met.
+type CancelScheduledResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledResponseValidationError) ErrorName() string {
+	return "CancelScheduledResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledResponseValidationError{}
+
+// Validate checks the field values on SetMessageExpiryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMessageExpiryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMessageExpiryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMessageExpiryResponseMultiError, or nil if none found.
+func (m *SetMessageExpiryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMessageExpiryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WithEmail
+
+	// no validation rules for Expiry
+
+	// no validation rules for AnnouncementMsgId
+
+	if len(errors) > 0 {
+		return SetMessageExpiryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMessageExpiryResponseMultiError is an error wrapping multiple validation
+// errors returned by SetMessageExpiryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetMessageExpiryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMessageExpiryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMessageExpiryResponseMultiError) AllErrors() []error { return m }
+
+// SetMessageExpiryResponseValidationError is the validation error returned by
+// SetMessageExpiryResponse.Validate if the designated constraints aren't met.
+type SetMessageExpiryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMessageExpiryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMessageExpiryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMessageExpiryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMessageExpiryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMessageExpiryResponseValidationError) ErrorName() string {
+	return "SetMessageExpiryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMessageExpiryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMessageExpiryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMessageExpiryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMessageExpiryResponseValidationError{}
+
+// Validate checks the field values on PinnedMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PinnedMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinnedMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PinnedMessageMultiError, or
+// nil if none found.
+func (m *PinnedMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinnedMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PinnedMessageValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PinnedBy
+
+	if all {
+		switch v := interface{}(m.GetPinnedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "PinnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PinnedMessageValidationError{
+					field:  "PinnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPinnedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PinnedMessageValidationError{
+				field:  "PinnedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PinnedMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinnedMessageMultiError is an error wrapping multiple validation errors
+// returned by PinnedMessage.ValidateAll() if the designated constraints
+// aren't met.
+type PinnedMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinnedMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinnedMessageMultiError) AllErrors() []error { return m }
+
+// PinnedMessageValidationError is the validation error returned by
+// PinnedMessage.Validate if the designated constraints aren't met.
+type PinnedMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinnedMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinnedMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinnedMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinnedMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinnedMessageValidationError) ErrorName() string { return "PinnedMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PinnedMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinnedMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinnedMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinnedMessageValidationError{}
+
+// Validate checks the field values on PinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageResponseMultiError, or nil if none found.
+func (m *PinMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPinned()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PinMessageResponseValidationError{
+					field:  "Pinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PinMessageResponseValidationError{
+					field:  "Pinned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPinned()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PinMessageResponseValidationError{
+				field:  "Pinned",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PinMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinMessageResponseMultiError is an error wrapping multiple validation errors
+// returned by PinMessageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageResponseMultiError) AllErrors() []error { return m }
+
+// PinMessageResponseValidationError is the validation error returned by
+// PinMessageResponse.Validate if the designated constraints aren't met.
+type PinMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageResponseValidationError) ErrorName() string {
+	return "PinMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageResponseValidationError{}
+
+// Validate checks the field values on UnpinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageResponseMultiError, or nil if none found.
+func (m *UnpinMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -979,55 +12031,1943 @@ func (m *GetHistoryResponse) validate(all bool) error {
 
 	// no validation rules for MsgId
 
-	// no validation rules for FromEmail
+	if len(errors) > 0 {
+		return UnpinMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageResponseMultiError) AllErrors() []error { return m }
+
+// UnpinMessageResponseValidationError is the validation error returned by
+// UnpinMessageResponse.Validate if the designated constraints aren't met.
+type UnpinMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageResponseValidationError) ErrorName() string {
+	return "UnpinMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageResponseValidationError{}
+
+// Validate checks the field values on ListPinnedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedResponseMultiError, or nil if none found.
+func (m *ListPinnedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPinned() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPinnedResponseValidationError{
+						field:  fmt.Sprintf("Pinned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPinnedResponseValidationError{
+						field:  fmt.Sprintf("Pinned[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPinnedResponseValidationError{
+					field:  fmt.Sprintf("Pinned[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPinnedResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPinnedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedResponseMultiError) AllErrors() []error { return m }
+
+// ListPinnedResponseValidationError is the validation error returned by
+// ListPinnedResponse.Validate if the designated constraints aren't met.
+type ListPinnedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedResponseValidationError) ErrorName() string {
+	return "ListPinnedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedResponseValidationError{}
+
+// Validate checks the field values on ForwardMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForwardMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForwardMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForwardMessageResponseMultiError, or nil if none found.
+func (m *ForwardMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForwardMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForwardMessageResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForwardMessageResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForwardMessageResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForwardMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForwardMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by ForwardMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ForwardMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForwardMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForwardMessageResponseMultiError) AllErrors() []error { return m }
+
+// ForwardMessageResponseValidationError is the validation error returned by
+// ForwardMessageResponse.Validate if the designated constraints aren't met.
+type ForwardMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForwardMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForwardMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForwardMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForwardMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForwardMessageResponseValidationError) ErrorName() string {
+	return "ForwardMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForwardMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForwardMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForwardMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForwardMessageResponseValidationError{}
+
+// Validate checks the field values on BlockedUser with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockedUser) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockedUser with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockedUserMultiError, or
+// nil if none found.
+func (m *BlockedUser) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockedUser) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if all {
+		switch v := interface{}(m.GetBlockedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockedUserValidationError{
+					field:  "BlockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockedUserValidationError{
+					field:  "BlockedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlockedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockedUserValidationError{
+				field:  "BlockedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlockedUserMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockedUserMultiError is an error wrapping multiple validation errors
+// returned by BlockedUser.ValidateAll() if the designated constraints aren't met.
+type BlockedUserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockedUserMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockedUserMultiError) AllErrors() []error { return m }
+
+// BlockedUserValidationError is the validation error returned by
+// BlockedUser.Validate if the designated constraints aren't met.
+type BlockedUserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockedUserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockedUserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockedUserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockedUserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockedUserValidationError) ErrorName() string { return "BlockedUserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockedUserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockedUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockedUserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockedUserValidationError{}
+
+// Validate checks the field values on BlockUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserResponseMultiError, or nil if none found.
+func (m *BlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlocked()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlockUserResponseValidationError{
+					field:  "Blocked",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlockUserResponseValidationError{
+					field:  "Blocked",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlocked()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlockUserResponseValidationError{
+				field:  "Blocked",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserResponseMultiError) AllErrors() []error { return m }
+
+// BlockUserResponseValidationError is the validation error returned by
+// BlockUserResponse.Validate if the designated constraints aren't met.
+type BlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserResponseValidationError) ErrorName() string {
+	return "BlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserResponseValidationError{}
+
+// Validate checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserResponseMultiError, or nil if none found.
+func (m *UnblockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return UnblockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockUserResponseMultiError is an error wrapping multiple validation
+// errors returned by UnblockUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnblockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserResponseMultiError) AllErrors() []error { return m }
+
+// UnblockUserResponseValidationError is the validation error returned by
+// UnblockUserResponse.Validate if the designated constraints aren't met.
+type UnblockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserResponseValidationError) ErrorName() string {
+	return "UnblockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserResponseValidationError{}
+
+// Validate checks the field values on ListBlockedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedResponseMultiError, or nil if none found.
+func (m *ListBlockedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlocked() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBlockedResponseValidationError{
+						field:  fmt.Sprintf("Blocked[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBlockedResponseValidationError{
+						field:  fmt.Sprintf("Blocked[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBlockedResponseValidationError{
+					field:  fmt.Sprintf("Blocked[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListBlockedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlockedResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBlockedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBlockedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedResponseMultiError) AllErrors() []error { return m }
+
+// ListBlockedResponseValidationError is the validation error returned by
+// ListBlockedResponse.Validate if the designated constraints aren't met.
+type ListBlockedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedResponseValidationError) ErrorName() string {
+	return "ListBlockedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedResponseValidationError{}
+
+// Validate checks the field values on MessageRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageRequestMultiError,
+// or nil if none found.
+func (m *MessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromEmail
+
+	// no validation rules for LastMessage
+
+	if all {
+		switch v := interface{}(m.GetLastMessageAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageRequestValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageRequestValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageRequestValidationError{
+				field:  "LastMessageAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MessageCount
+
+	if all {
+		switch v := interface{}(m.GetRequestedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageRequestValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageRequestValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequestedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageRequestValidationError{
+				field:  "RequestedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageRequestMultiError is an error wrapping multiple validation errors
+// returned by MessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageRequestMultiError) AllErrors() []error { return m }
+
+// MessageRequestValidationError is the validation error returned by
+// MessageRequest.Validate if the designated constraints aren't met.
+type MessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageRequestValidationError) ErrorName() string { return "MessageRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageRequestValidationError{}
+
+// Validate checks the field values on ListMessageRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessageRequestsResponseMultiError, or nil if none found.
+func (m *ListMessageRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessageRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessageRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMessageRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListMessageRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMessageRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMessageRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListMessageRequestsResponseValidationError is the validation error returned
+// by ListMessageRequestsResponse.Validate if the designated constraints
+// aren't met.
+type ListMessageRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageRequestsResponseValidationError) ErrorName() string {
+	return "ListMessageRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageRequestsResponseValidationError{}
+
+// Validate checks the field values on RespondToMessageRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RespondToMessageRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RespondToMessageRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RespondToMessageRequestResponseMultiError, or nil if none found.
+func (m *RespondToMessageRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RespondToMessageRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromEmail
+
+	// no validation rules for Action
+
+	// no validation rules for Delivered
+
+	if len(errors) > 0 {
+		return RespondToMessageRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RespondToMessageRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RespondToMessageRequestResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RespondToMessageRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RespondToMessageRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RespondToMessageRequestResponseMultiError) AllErrors() []error { return m }
+
+// RespondToMessageRequestResponseValidationError is the validation error
+// returned by RespondToMessageRequestResponse.Validate if the designated
+// constraints aren't met.
+type RespondToMessageRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RespondToMessageRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RespondToMessageRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RespondToMessageRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RespondToMessageRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RespondToMessageRequestResponseValidationError) ErrorName() string {
+	return "RespondToMessageRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RespondToMessageRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRespondToMessageRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RespondToMessageRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RespondToMessageRequestResponseValidationError{}
+
+// Validate checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Profile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProfileMultiError, or nil if none found.
+func (m *Profile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Profile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarAttachmentId
+
+	// no validation rules for Status
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return ProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileMultiError is an error wrapping multiple validation errors returned
+// by Profile.ValidateAll() if the designated constraints aren't met.
+type ProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileMultiError) AllErrors() []error { return m }
+
+// ProfileValidationError is the validation error returned by Profile.Validate
+// if the designated constraints aren't met.
+type ProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileValidationError) ErrorName() string { return "ProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileValidationError{}
+
+// Validate checks the field values on ProfileSnippet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProfileSnippet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileSnippet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProfileSnippetMultiError,
+// or nil if none found.
+func (m *ProfileSnippet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileSnippet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarAttachmentId
+
+	if len(errors) > 0 {
+		return ProfileSnippetMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileSnippetMultiError is an error wrapping multiple validation errors
+// returned by ProfileSnippet.ValidateAll() if the designated constraints
+// aren't met.
+type ProfileSnippetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileSnippetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileSnippetMultiError) AllErrors() []error { return m }
+
+// ProfileSnippetValidationError is the validation error returned by
+// ProfileSnippet.Validate if the designated constraints aren't met.
+type ProfileSnippetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileSnippetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileSnippetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileSnippetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileSnippetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileSnippetValidationError) ErrorName() string { return "ProfileSnippetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileSnippetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileSnippet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileSnippetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileSnippetValidationError{}
+
+// Validate checks the field values on GetProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileResponseMultiError, or nil if none found.
+func (m *GetProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileResponseMultiError is an error wrapping multiple validation errors
+// returned by GetProfileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetProfileResponseValidationError is the validation error returned by
+// GetProfileResponse.Validate if the designated constraints aren't met.
+type GetProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileResponseValidationError) ErrorName() string {
+	return "GetProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileResponseValidationError{}
+
+// Validate checks the field values on UpdateProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProfileResponseMultiError, or nil if none found.
+func (m *UpdateProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProfileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProfileResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProfileResponseValidationError is the validation error returned by
+// UpdateProfileResponse.Validate if the designated constraints aren't met.
+type UpdateProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProfileResponseValidationError) ErrorName() string {
+	return "UpdateProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProfileResponseValidationError{}
+
+// Validate checks the field values on BatchGetProfilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetProfilesResponseMultiError, or nil if none found.
+func (m *BatchGetProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProfiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetProfilesResponseValidationError{
+					field:  fmt.Sprintf("Profiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchGetProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
 
-	// no validation rules for ToEmail
+// BatchGetProfilesResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProfilesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProfilesResponseMultiError []error
 
-	// no validation rules for Content
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProfilesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetSentAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetHistoryResponseValidationError{
-					field:  "SentAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProfilesResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetProfilesResponseValidationError is the validation error returned by
+// BatchGetProfilesResponse.Validate if the designated constraints aren't met.
+type BatchGetProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProfilesResponseValidationError) ErrorName() string {
+	return "BatchGetProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProfilesResponseValidationError{}
+
+// Validate checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetHistoryResponseValidationError{
-					field:  "SentAt",
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetHistoryResponseValidationError{
-				field:  "SentAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
 	}
 
 	if len(errors) > 0 {
-		return GetHistoryResponseMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetHistoryResponseMultiError is an error wrapping multiple validation errors
-// returned by GetHistoryResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetHistoryResponseMultiError []error
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetHistoryResponseMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1036,11 +13976,11 @@ func (m GetHistoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetHistoryResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// GetHistoryResponseValidationError is the validation error returned by
-// GetHistoryResponse.Validate if the designated constraints aren't met.
-type GetHistoryResponseValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1048,24 +13988,24 @@ type GetHistoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetHistoryResponseValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetHistoryResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetHistoryResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetHistoryResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetHistoryResponseValidationError) ErrorName() string {
-	return "GetHistoryResponseValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetHistoryResponseValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1077,14 +14017,14 @@ func (e GetHistoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetHistoryResponse.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetHistoryResponseValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1092,59 +14032,192 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetHistoryResponseValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on ChatStreamResponse with the rules
+// Validate checks the field values on SetDiscoverableResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChatStreamResponse) Validate() error {
+func (m *SetDiscoverableResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChatStreamResponse with the rules
+// ValidateAll checks the field values on SetDiscoverableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDiscoverableResponseMultiError, or nil if none found.
+func (m *SetDiscoverableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDiscoverableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Discoverable
+
+	if len(errors) > 0 {
+		return SetDiscoverableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDiscoverableResponseMultiError is an error wrapping multiple validation
+// errors returned by SetDiscoverableResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetDiscoverableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDiscoverableResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDiscoverableResponseMultiError) AllErrors() []error { return m }
+
+// SetDiscoverableResponseValidationError is the validation error returned by
+// SetDiscoverableResponse.Validate if the designated constraints aren't met.
+type SetDiscoverableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDiscoverableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDiscoverableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDiscoverableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDiscoverableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDiscoverableResponseValidationError) ErrorName() string {
+	return "SetDiscoverableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDiscoverableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDiscoverableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDiscoverableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDiscoverableResponseValidationError{}
+
+// Validate checks the field values on RefreshTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChatStreamResponseMultiError, or nil if none found.
-func (m *ChatStreamResponse) ValidateAll() error {
+// RefreshTokenResponseMultiError, or nil if none found.
+func (m *RefreshTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChatStreamResponse) validate(all bool) error {
+func (m *RefreshTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MsgId
+	// no validation rules for Token
 
-	// no validation rules for FromEmail
+	// no validation rules for UserId
 
-	// no validation rules for Content
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshTokenResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshTokenResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefreshToken
 
 	if all {
-		switch v := interface{}(m.GetSentAt()).(type) {
+		switch v := interface{}(m.GetRefreshTokenExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChatStreamResponseValidationError{
-					field:  "SentAt",
+				errors = append(errors, RefreshTokenResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChatStreamResponseValidationError{
-					field:  "SentAt",
+				errors = append(errors, RefreshTokenResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRefreshTokenExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChatStreamResponseValidationError{
-				field:  "SentAt",
+			return RefreshTokenResponseValidationError{
+				field:  "RefreshTokenExpiresAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1152,19 +14225,19 @@ func (m *ChatStreamResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChatStreamResponseMultiError(errors)
+		return RefreshTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChatStreamResponseMultiError is an error wrapping multiple validation errors
-// returned by ChatStreamResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ChatStreamResponseMultiError []error
+// RefreshTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChatStreamResponseMultiError) Error() string {
+func (m RefreshTokenResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1173,11 +14246,11 @@ func (m ChatStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChatStreamResponseMultiError) AllErrors() []error { return m }
+func (m RefreshTokenResponseMultiError) AllErrors() []error { return m }
 
-// ChatStreamResponseValidationError is the validation error returned by
-// ChatStreamResponse.Validate if the designated constraints aren't met.
-type ChatStreamResponseValidationError struct {
+// RefreshTokenResponseValidationError is the validation error returned by
+// RefreshTokenResponse.Validate if the designated constraints aren't met.
+type RefreshTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1185,24 +14258,24 @@ type ChatStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChatStreamResponseValidationError) Field() string { return e.field }
+func (e RefreshTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChatStreamResponseValidationError) Reason() string { return e.reason }
+func (e RefreshTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChatStreamResponseValidationError) Cause() error { return e.cause }
+func (e RefreshTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChatStreamResponseValidationError) Key() bool { return e.key }
+func (e RefreshTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChatStreamResponseValidationError) ErrorName() string {
-	return "ChatStreamResponseValidationError"
+func (e RefreshTokenResponseValidationError) ErrorName() string {
+	return "RefreshTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChatStreamResponseValidationError) Error() string {
+func (e RefreshTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1214,14 +14287,14 @@ func (e ChatStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChatStreamResponse.%s: %s%s",
+		"invalid %sRefreshTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChatStreamResponseValidationError{}
+var _ error = RefreshTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1229,4 +14302,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChatStreamResponseValidationError{}
+} = RefreshTokenResponseValidationError{}